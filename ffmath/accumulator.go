@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Fixed timestep accumulator, for running physics at a stable rate
+// independent of the frame rate.
+//
+// Each frame, add the frame's delta with [Accumulator.Add], run the physics
+// step the number of times returned by [Accumulator.Consume], and then
+// use [Accumulator.Alpha] to interpolate between the previous and current
+// physics state when rendering:
+//
+//	acc.Add(delta)
+//	for range acc.Consume() {
+//		prev = curr
+//		curr = physicsStep(curr, acc.Step)
+//	}
+//	render(prev.Lerp(curr, acc.Alpha()))
+type Accumulator struct {
+	// Fixed time per physics step, in the same unit as the frame delta.
+	Step float32
+	// Maximum number of steps returned by [Accumulator.Consume] per frame.
+	//
+	// Caps the work done on slow frames, to avoid the "spiral of death" where
+	// each frame takes longer than the last because it has more steps to catch up on.
+	// Any time beyond the cap is dropped, making the simulation slow down instead.
+	// Zero or negative means no cap.
+	MaxSteps int
+
+	accumulated float32
+}
+
+// Creates a new [Accumulator] with the given fixed step,
+// and with [Accumulator.MaxSteps] set to 5.
+func NewAccumulator(step float32) Accumulator {
+	return Accumulator{Step: step, MaxSteps: 5}
+}
+
+// Adds the time elapsed since the last frame.
+func (a *Accumulator) Add(frameDelta float32) {
+	a.accumulated += frameDelta
+}
+
+// Returns how many fixed steps to run this frame,
+// and removes their time from the accumulator.
+//
+// The leftover time that doesn't fill a whole step is kept for the next frame.
+func (a *Accumulator) Consume() (steps int) {
+	if a.Step <= 0 {
+		return 0
+	}
+	steps = int(a.accumulated / a.Step)
+	if a.MaxSteps > 0 && steps > a.MaxSteps {
+		steps = a.MaxSteps
+		// drop the time that can't be caught up on
+		a.accumulated = Repeat(a.accumulated, a.Step)
+		return steps
+	}
+	a.accumulated -= float32(steps) * a.Step
+	return steps
+}
+
+// Fraction of a step that is left over in the accumulator, in the range [0, 1),
+// used to interpolate between the previous and current physics state when rendering.
+func (a *Accumulator) Alpha() float32 {
+	if a.Step <= 0 {
+		return 0
+	}
+	return Clamp(a.accumulated/a.Step, 0, 1)
+}
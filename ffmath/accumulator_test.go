@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestAccumulator(t *testing.T) {
+	t.Parallel()
+	acc := NewAccumulator(0.25)
+	tests := []struct {
+		delta     float32
+		wantSteps int
+		wantAlpha float32
+	}{
+		{delta: 0.125, wantSteps: 0, wantAlpha: 0.5},
+		{delta: 0.125, wantSteps: 1, wantAlpha: 0},
+		{delta: 0.375, wantSteps: 1, wantAlpha: 0.5},
+		{delta: 0.5, wantSteps: 2, wantAlpha: 0.5},
+		{delta: 0.0625, wantSteps: 0, wantAlpha: 0.75},
+		{delta: 0.0625, wantSteps: 1, wantAlpha: 0},
+		// capped at MaxSteps=5, dropping the rest of the whole steps
+		{delta: 2.125, wantSteps: 5, wantAlpha: 0.5},
+		{delta: 0.125, wantSteps: 1, wantAlpha: 0},
+	}
+
+	totalSteps := 0
+	for i, test := range tests {
+		acc.Add(test.delta)
+		steps := acc.Consume()
+		totalSteps += steps
+		if steps != test.wantSteps || !EqualApprox(acc.Alpha(), test.wantAlpha) {
+			t.Errorf("frame %d, Add(%v): want %d steps with alpha %v, got %d steps with alpha %v",
+				i, test.delta, test.wantSteps, test.wantAlpha, steps, acc.Alpha())
+		}
+	}
+	if totalSteps != 11 {
+		t.Errorf("want 11 steps in total, got %d", totalSteps)
+	}
+}
+
+func TestAccumulatorUncapped(t *testing.T) {
+	t.Parallel()
+	acc := Accumulator{Step: 0.5}
+	acc.Add(10.25)
+	if steps := acc.Consume(); steps != 20 {
+		t.Errorf("Consume(): want 20, got %d", steps)
+	}
+	if alpha := acc.Alpha(); !EqualApprox(alpha, 0.5) {
+		t.Errorf("Alpha(): want 0.5, got %v", alpha)
+	}
+}
@@ -59,3 +59,143 @@ func RotateTowards(from, to, delta firefly.Angle) firefly.Angle {
 		from.Radians() + Clamp(delta.Radians(), absDiff-math.Pi, absDiff)*tinymath.Sign(diff),
 	)
 }
+
+// Multiplies an angle by a factor, e.g ScaleAngle(90°, 2) == 180°.
+//
+// The result is not normalized.
+func ScaleAngle(a firefly.Angle, factor float32) firefly.Angle {
+	return firefly.Radians(a.Radians() * factor)
+}
+
+// Negates an angle, e.g NegateAngle(90°) == -90°.
+//
+// This is the same as [firefly.Angle.Neg], named to read naturally
+// alongside the other angle functions in this package.
+// The result is not normalized.
+func NegateAngle(a firefly.Angle) firefly.Angle {
+	return a.Neg()
+}
+
+// Rotates "current" towards the direction from "from" to "target" by at most
+// turnRate*delta, such as for a turret that tracks its target at a fixed
+// angular speed.
+//
+// The desired direction uses [Vec.Azimuth], and the rotation uses [RotateTowards],
+// so it takes the shortest way around and will not overshoot the target direction.
+// If "from" and "target" are the same position then the direction is
+// the same as [V](0, 0).Azimuth().
+//
+// The returned angle uses the [Vec.Azimuth] convention, where 90° points down,
+// which is mirrored on the Y axis compared to [VAngle] and [AngleToVec].
+// Convert it to a direction with [V](speed, 0).Rotate(angle).
+//
+// Uses [tinymath] for faster but less accurate calculation, with error of `0.1620` degrees.
+func AimTowards(current firefly.Angle, target Vec, from Vec, turnRate firefly.Angle, delta float32) firefly.Angle {
+	desired := target.Sub(from).Azimuth()
+	return RotateTowards(current, desired, ScaleAngle(turnRate, delta))
+}
+
+// Converts a [firefly.Angle] to a [Vec] with the given length.
+//
+// This is the same as [VAngle] scaled by "length", and uses the same
+// convention where the angle goes counter-clockwise on the screen,
+// so 90° points up, i.e AngleToVec(90°, 5) == {0, -5}.
+//
+// This is mirrored on the Y axis compared to [Vec.Azimuth] and [AimTowards],
+// where 90° points down. Angles from those must instead be converted with
+// [Polar]{a, length}.ToVec() or [V](length, 0).Rotate(a).
+func AngleToVec(a firefly.Angle, length float32) Vec {
+	return VAngle(a).Scale(length)
+}
+
+// The opposite angle, i.e the angle rotated by 180°.
+//
+// The result is normalized to the range [0°, 360°).
+func OppositeAngle(a firefly.Angle) firefly.Angle {
+	return firefly.Radians(Repeat(a.Radians()+math.Pi, tinymath.Tau))
+}
+
+// Wraps an angle into the half-open range [min, max) by wrapping around
+// instead of clamping, such as wrapping into [-180°, 180°).
+//
+// The range is usually a full turn (360°), but can be smaller, e.g to
+// constrain a turret's rotation to a cone.
+//
+// See [Wrap] for more details.
+func WrapAngleRange(a, min, max firefly.Angle) firefly.Angle {
+	return firefly.Radians(Wrap(a.Radians(), min.Radians(), max.Radians()))
+}
+
+// Advances an angle by an angular velocity over "delta" time,
+// such as for a spinning object, e.g IntegrateAngle(350°, 40°, 0.5) == 10°.
+//
+// The result is normalized to the range [0°, 360°), so the angle can be
+// integrated every frame without growing unbounded.
+// Use [WrapAngleRange] to normalize into a different range.
+func IntegrateAngle(a firefly.Angle, angularVelocity firefly.Angle, delta float32) firefly.Angle {
+	return firefly.Radians(Repeat(a.Radians()+angularVelocity.Radians()*delta, tinymath.Tau))
+}
+
+// Computes both the sine and cosine of an angle.
+//
+// Useful together with [Vec.RotateSinCos] to rotate many vectors by the same
+// angle, without recalculating the trigonometry for each vector.
+//
+// Uses [tinymath.SinCos] for faster but less accurate calculation, with a max error of 0.002.
+func SinCos(a firefly.Angle) (sin, cos float32) {
+	return tinymath.SinCos(a.Radians())
+}
+
+// Clamps an angle to the arc going from "min" to "max" in the positive
+// direction (increasing angle).
+//
+// If "a" is inside the arc then it is returned unchanged.
+// Otherwise it is snapped to whichever arc edge is angularly closest,
+// as measured by [AngleDifference]. When both edges are equally far away,
+// "min" is returned.
+//
+// The arc may span the 0°/360° seam. For example, an arc from 300° to 60°
+// covers 120°, and 0° is inside it, while 180° is clamped to 60°.
+// Swapping "min" and "max" selects the complementary arc, from 60° to 300°.
+//
+// Input angles do not need to be normalized.
+func ClampAngle(a, min, max firefly.Angle) firefly.Angle {
+	arc := Repeat(max.Radians()-min.Radians(), tinymath.Tau)
+	offset := Repeat(a.Radians()-min.Radians(), tinymath.Tau)
+	if offset <= arc {
+		return a
+	}
+	toMin := tinymath.Abs(AngleDifference(a, min).Radians())
+	toMax := tinymath.Abs(AngleDifference(a, max).Radians())
+	if toMax < toMin {
+		return max
+	}
+	return min
+}
+
+var compassLabels = [8]string{"E", "SE", "S", "SW", "W", "NW", "N", "NE"}
+
+// Get the label of the nearest of the 8 compass directions,
+// such as for a minimap compass or debug overlays.
+//
+// Uses the same screen convention as [Vec.Azimuth], where Y points down,
+// so north is up on the screen:
+//
+//   - 0° is "E" (right)
+//   - 45° is "SE"
+//   - 90° is "S" (down)
+//   - 135° is "SW"
+//   - 180° is "W" (left)
+//   - 225° is "NW"
+//   - 270° is "N" (up)
+//   - 315° is "NE"
+//
+// Each direction covers 45°, centered on the angles above.
+// Angles exactly between two directions, such as 22.5°,
+// pick the direction with the larger angle ("SE" in that case).
+// Input angles do not need to be normalized.
+func CompassLabel(a firefly.Angle) string {
+	deg := Repeat(a.Degrees(), 360)
+	index := int(tinymath.Floor(deg/45+0.5)) % len(compassLabels)
+	return compassLabels[index]
+}
@@ -69,3 +69,252 @@ func TestRotateTowards(t *testing.T) {
 		}
 	}
 }
+
+func TestOppositeAngle(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		deg     float32
+		wantDeg float32
+	}{
+		{deg: 0, wantDeg: 180},
+		{deg: 90, wantDeg: 270},
+		{deg: 180, wantDeg: 0},
+		{deg: 270, wantDeg: 90},
+		{deg: -90, wantDeg: 90},
+		{deg: 720, wantDeg: 180},
+	}
+
+	for _, test := range tests {
+		result := OppositeAngle(firefly.Degrees(test.deg))
+		resultDeg := tinymath.Round(result.Degrees())
+		if resultDeg != test.wantDeg {
+			t.Errorf("OppositeAngle(%f°)\nwant: %f°\ngot:  %f°", test.deg, test.wantDeg, resultDeg)
+		}
+		twice := OppositeAngle(result)
+		wantTwice := firefly.Degrees(test.deg).Normalize()
+		if !EqualApprox(twice.Radians(), wantTwice.Radians()) {
+			t.Errorf("OppositeAngle(OppositeAngle(%f°))\nwant: %f°\ngot:  %f°", test.deg, wantTwice.Degrees(), twice.Degrees())
+		}
+	}
+}
+
+func TestAngleToVec(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		deg    float32
+		length float32
+	}{
+		{deg: 0, length: 1},
+		{deg: 45, length: 10},
+		{deg: 200, length: 3.5},
+		{deg: 300, length: 0},
+	}
+
+	for _, test := range tests {
+		result := AngleToVec(firefly.Degrees(test.deg), test.length)
+		length := sqrtPrecise(result.RadiusSquared())
+		// tinymath.Cos and tinymath.Sin has a max error of 0.002
+		if Abs(length-test.length) > 0.005*test.length {
+			t.Errorf("AngleToVec(%f°, %f)\nwant length: %f\ngot:         %f", test.deg, test.length, test.length, length)
+		}
+	}
+}
+
+func TestWrapAngleRange(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		deg     float32
+		minDeg  float32
+		maxDeg  float32
+		wantDeg float32
+	}{
+		{deg: 0, minDeg: -45, maxDeg: 45, wantDeg: 0},
+		{deg: 30, minDeg: -45, maxDeg: 45, wantDeg: 30},
+		{deg: 50, minDeg: -45, maxDeg: 45, wantDeg: -40},
+		{deg: -50, minDeg: -45, maxDeg: 45, wantDeg: 40},
+		{deg: 350, minDeg: -45, maxDeg: 45, wantDeg: -10},
+		{deg: 370, minDeg: -45, maxDeg: 45, wantDeg: 10},
+		{deg: 350, minDeg: -180, maxDeg: 180, wantDeg: -10},
+		{deg: -10, minDeg: 0, maxDeg: 360, wantDeg: 350},
+		{deg: 730, minDeg: 0, maxDeg: 360, wantDeg: 10},
+	}
+
+	for _, test := range tests {
+		result := WrapAngleRange(
+			firefly.Degrees(test.deg),
+			firefly.Degrees(test.minDeg),
+			firefly.Degrees(test.maxDeg))
+		resultDeg := tinymath.Round(result.Degrees())
+		if resultDeg != test.wantDeg {
+			t.Errorf("WrapAngleRange(%f°, %f°, %f°)\nwant: %f°\ngot:  %f°",
+				test.deg, test.minDeg, test.maxDeg, test.wantDeg, resultDeg)
+		}
+	}
+}
+
+func TestClampAngle(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		deg     float32
+		minDeg  float32
+		maxDeg  float32
+		wantDeg float32
+	}{
+		{name: "inside", deg: 45, minDeg: 0, maxDeg: 90, wantDeg: 45},
+		{name: "on min edge", deg: 0, minDeg: 0, maxDeg: 90, wantDeg: 0},
+		{name: "on max edge", deg: 90, minDeg: 0, maxDeg: 90, wantDeg: 90},
+		{name: "just below min", deg: -10, minDeg: 0, maxDeg: 90, wantDeg: 0},
+		{name: "just above max", deg: 100, minDeg: 0, maxDeg: 90, wantDeg: 90},
+		{name: "opposite closer to max", deg: 224, minDeg: 0, maxDeg: 90, wantDeg: 90},
+		{name: "opposite closer to min", deg: 226, minDeg: 0, maxDeg: 90, wantDeg: 0},
+		{name: "seam inside", deg: 0, minDeg: 300, maxDeg: 60, wantDeg: 0},
+		{name: "seam inside unnormalized", deg: 330, minDeg: -60, maxDeg: 60, wantDeg: 330},
+		{name: "seam just below min", deg: 290, minDeg: 300, maxDeg: 60, wantDeg: 300},
+		{name: "seam just above max", deg: 70, minDeg: 300, maxDeg: 60, wantDeg: 60},
+		{name: "seam opposite", deg: 170, minDeg: 300, maxDeg: 60, wantDeg: 60},
+		{name: "complementary arc", deg: 10, minDeg: 60, maxDeg: 300, wantDeg: 60},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := ClampAngle(firefly.Degrees(test.deg), firefly.Degrees(test.minDeg), firefly.Degrees(test.maxDeg))
+			resultDeg := tinymath.Round(result.Degrees())
+			if resultDeg != test.wantDeg {
+				t.Errorf("ClampAngle(%f°, %f°, %f°)\nwant: %f°\ngot:  %f°",
+					test.deg, test.minDeg, test.maxDeg, test.wantDeg, resultDeg)
+			}
+		})
+	}
+}
+
+func TestIntegrateAngle(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		deg     float32
+		velDeg  float32
+		delta   float32
+		wantDeg float32
+	}{
+		{deg: 0, velDeg: 90, delta: 1, wantDeg: 90},
+		{deg: 90, velDeg: 90, delta: 0.5, wantDeg: 135},
+		{deg: 350, velDeg: 40, delta: 0.5, wantDeg: 10},  // across the seam
+		{deg: 10, velDeg: -40, delta: 0.5, wantDeg: 350}, // backwards across the seam
+		{deg: 180, velDeg: -90, delta: 1, wantDeg: 90},   // negative velocity
+		{deg: 0, velDeg: 720, delta: 1.25, wantDeg: 180}, // multiple turns
+		{deg: 45, velDeg: 100, delta: 0, wantDeg: 45},    // no time passed
+		{deg: -90, velDeg: 0, delta: 1, wantDeg: 270},    // input is normalized
+	}
+
+	for _, test := range tests {
+		result := IntegrateAngle(firefly.Degrees(test.deg), firefly.Degrees(test.velDeg), test.delta)
+		resultDeg := tinymath.Round(result.Degrees())
+		if resultDeg != test.wantDeg {
+			t.Errorf("IntegrateAngle(%f°, %f°, %f)\nwant: %f°\ngot:  %f°", test.deg, test.velDeg, test.delta, test.wantDeg, resultDeg)
+		}
+		if r := result.Radians(); r < 0 || r >= tinymath.Tau {
+			t.Errorf("IntegrateAngle(%f°, %f°, %f) = %f rad, want in range [0, 2π)", test.deg, test.velDeg, test.delta, r)
+		}
+	}
+}
+
+func TestScaleAngle(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		deg     float32
+		factor  float32
+		wantDeg float32
+	}{
+		{deg: 90, factor: 2, wantDeg: 180},
+		{deg: 90, factor: 0.5, wantDeg: 45},
+		{deg: 270, factor: 2, wantDeg: 540},
+		{deg: 30, factor: -1, wantDeg: -30},
+		{deg: 30, factor: 0, wantDeg: 0},
+	}
+
+	for _, test := range tests {
+		result := ScaleAngle(firefly.Degrees(test.deg), test.factor)
+		resultDeg := tinymath.Round(result.Degrees())
+		if resultDeg != test.wantDeg {
+			t.Errorf("ScaleAngle(%f°, %f)\nwant: %f°\ngot:  %f°", test.deg, test.factor, test.wantDeg, resultDeg)
+		}
+	}
+}
+
+func TestNegateAngle(t *testing.T) {
+	t.Parallel()
+	for _, deg := range []float32{0, 45, -90, 180, 400} {
+		a := firefly.Degrees(deg)
+		negated := NegateAngle(a)
+		if !EqualApprox(negated.Radians(), -a.Radians()) {
+			t.Errorf("NegateAngle(%f°)\nwant: %f°\ngot:  %f°", deg, -deg, negated.Degrees())
+		}
+		if twice := NegateAngle(negated); twice != a {
+			t.Errorf("NegateAngle(NegateAngle(%f°))\nwant: %f°\ngot:  %f°", deg, deg, twice.Degrees())
+		}
+	}
+}
+
+func TestCompassLabel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		deg  float32
+		want string
+	}{
+		{deg: 0, want: "E"},
+		{deg: 45, want: "SE"},
+		{deg: 90, want: "S"},
+		{deg: 135, want: "SW"},
+		{deg: 180, want: "W"},
+		{deg: 225, want: "NW"},
+		{deg: 270, want: "N"},
+		{deg: 315, want: "NE"},
+		{deg: 360, want: "E"},
+		{deg: -90, want: "N"},
+		{deg: 22.4, want: "E"},
+		{deg: 22.5, want: "SE"},
+		{deg: 337.6, want: "E"},
+		{deg: 337.4, want: "NE"},
+	}
+
+	for _, test := range tests {
+		if got := CompassLabel(firefly.Degrees(test.deg)); got != test.want {
+			t.Errorf("CompassLabel(%f°): want %q, got %q", test.deg, test.want, got)
+		}
+	}
+
+	// matches the direction of Vec.Azimuth
+	if got := CompassLabel(V(0, -1).Azimuth()); got != "N" {
+		t.Errorf("CompassLabel(V(0, -1).Azimuth()): want %q, got %q", "N", got)
+	}
+}
+
+func TestAimTowards(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		currentDeg float32
+		target     Vec
+		from       Vec
+		turnDeg    float32
+		delta      float32
+		wantDeg    float32
+	}{
+		{name: "within reach", currentDeg: 0, target: V(10, 12), from: V(10, 2), turnDeg: 180, delta: 1, wantDeg: 90},
+		{name: "beyond reach", currentDeg: 0, target: V(10, 12), from: V(10, 2), turnDeg: 30, delta: 0.5, wantDeg: 15},
+		{name: "beyond reach other way", currentDeg: 0, target: V(10, -8), from: V(10, 2), turnDeg: 60, delta: 0.5, wantDeg: -30},
+		{name: "across seam", currentDeg: 350, target: V(5, 5), from: V(0, 0), turnDeg: 20, delta: 1, wantDeg: 370},
+		{name: "already aimed", currentDeg: 180, target: V(-5, 0), from: V(0, 0), turnDeg: 90, delta: 1, wantDeg: 180},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := AimTowards(firefly.Degrees(test.currentDeg), test.target, test.from, firefly.Degrees(test.turnDeg), test.delta)
+			// Vec.Azimuth has an error of 0.1620 degrees
+			if diff := AngleDifference(firefly.Degrees(test.wantDeg), result); tinymath.Abs(diff.Degrees()) > 0.2 {
+				t.Errorf("AimTowards(%f°, %v, %v, %f°, %v)\nwant: %f°\ngot:  %f°",
+					test.currentDeg, test.target, test.from, test.turnDeg, test.delta, test.wantDeg, result.Degrees())
+			}
+		})
+	}
+}
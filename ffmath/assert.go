@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Panics if [DebugAsserts] is enabled and the vector is not normalized.
+//
+// As [Vec.Normalize] uses [tinymath] with an average deviation of ~5%,
+// this allows the vector's length to deviate by about 5% from 1.
+func AssertNormalized(v Vec) {
+	if !DebugAsserts {
+		return
+	}
+	if r := v.RadiusSquared(); !(Abs(r-1) <= 0.1) {
+		assertFailed("ffmath: vector %v is not normalized, has squared length %v", v, r)
+	}
+}
+
+// Panics if [DebugAsserts] is enabled and the value is not in the
+// closed range [min, max].
+//
+// NaN values are never in range.
+func AssertInRange[T Number](v, min, max T) {
+	if !DebugAsserts {
+		return
+	}
+	if !(v >= min && v <= max) {
+		assertFailed("ffmath: value %v is not in range [%v, %v]", v, min, max)
+	}
+}
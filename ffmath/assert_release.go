@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+//go:build !ffmath_debug
+
+package ffmath
+
+// Enables the debug assertions, such as [AssertNormalized] and [AssertInRange].
+//
+// The assertions are only enabled when building with the "ffmath_debug"
+// build tag, such as in tests and debug builds, to catch bugs such as
+// non-normalized directions early:
+//
+//	go test -tags ffmath_debug ./...
+//
+// Otherwise this is false, and the compiler removes the assertions
+// completely as dead code.
+const DebugAsserts = false
+
+// Never called, as all calls are behind a check of [DebugAsserts].
+// Kept without formatting to not pull in the fmt package.
+func assertFailed(format string, _ ...any) {
+	panic(format)
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"math"
+	"testing"
+)
+
+// Run with the "ffmath_debug" build tag to test the enabled assertions.
+func TestAsserts(t *testing.T) {
+	t.Parallel()
+	assertPanics := func(t *testing.T, name string, want bool, f func()) {
+		t.Helper()
+		defer func() {
+			t.Helper()
+			if got := recover() != nil; got != want {
+				t.Errorf("%s: want panic=%t, got panic=%t", name, want, got)
+			}
+		}()
+		f()
+	}
+
+	// the failing assertions only panic when enabled
+	fails := DebugAsserts
+	assertPanics(t, "AssertNormalized(V(1, 0))", false, func() { AssertNormalized(V(1, 0)) })
+	assertPanics(t, "AssertNormalized(V(3, 4).Normalize())", false, func() { AssertNormalized(V(3, 4).Normalize()) })
+	assertPanics(t, "AssertNormalized(V(3, 4))", fails, func() { AssertNormalized(V(3, 4)) })
+	assertPanics(t, "AssertNormalized(V(0, 0))", fails, func() { AssertNormalized(V(0, 0)) })
+	assertPanics(t, "AssertNormalized(V(NaN, 0))", fails, func() { AssertNormalized(V(float32(math.NaN()), 0)) })
+	assertPanics(t, "AssertInRange(5, 0, 10)", false, func() { AssertInRange(5, 0, 10) })
+	assertPanics(t, "AssertInRange(0, 0, 10)", false, func() { AssertInRange(0, 0, 10) })
+	assertPanics(t, "AssertInRange(10, 0, 10)", false, func() { AssertInRange(10, 0, 10) })
+	assertPanics(t, "AssertInRange(11, 0, 10)", fails, func() { AssertInRange(11, 0, 10) })
+	assertPanics(t, "AssertInRange(-0.5, 0, 1)", fails, func() { AssertInRange(-0.5, 0, 1) })
+	assertPanics(t, "AssertInRange(NaN, 0, 1)", fails, func() { AssertInRange(math.NaN(), 0, 1) })
+}
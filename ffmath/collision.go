@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "math"
+
+// Checks when a line segment from "p0" to "p1" first touches a circle.
+//
+// Useful for fast-moving objects such as bullets, which would otherwise
+// tunnel through thin colliders when only checked at discrete positions.
+//
+// Returns the fraction "t" in the range [0, 1] along the segment at the
+// point of first contact, where p0.Add(p1.Sub(p0).Scale(t)) is the contact point.
+// If "p0" already is inside the circle then t=0 is returned.
+func SegmentCircleSweep(p0, p1 Vec, center Vec, radius float32) (t float32, hit bool) {
+	offset := p0.Sub(center)
+	c := offset.RadiusSquared() - radius*radius
+	if c <= 0 {
+		// start inside circle
+		return 0, true
+	}
+	dir := p1.Sub(p0)
+	a := dir.RadiusSquared()
+	if a == 0 {
+		return 0, false
+	}
+	b := 2 * offset.Dot(dir)
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return 0, false
+	}
+	t = (-b - sqrtPrecise(discriminant)) / (2 * a)
+	if t < 0 || t > 1 {
+		return 0, false
+	}
+	return t, true
+}
+
+// Checks when two moving circles first touch during the time step "delta",
+// also known as the time of impact.
+//
+// Returns the fraction "t" in the range [0, 1] of "delta" at which the circles
+// first touch, where posA.Add(velA.Scale(t*delta)) is the position of circle A
+// at the time of impact.
+// If the circles are already overlapping then t=0 is returned.
+func CircleSweep(posA Vec, velA Vec, radA float32, posB Vec, velB Vec, radB float32, delta float32) (t float32, hit bool) {
+	// Solve it in the frame of reference of circle B, where B stands still
+	// and A moves along a segment, against a circle with the combined radius.
+	relativeMove := velA.Sub(velB).Scale(delta)
+	return SegmentCircleSweep(posA, posA.Add(relativeMove), posB, radA+radB)
+}
+
+// Calculates the minimum translation vector (MTV) that moves polygon "a"
+// out of polygon "b", using the separating axis theorem (SAT).
+//
+// After moving "a" by the returned vector the polygons are just touching.
+// Returns false if the polygons are not overlapping, where touching edges
+// are not counted as overlapping.
+//
+// Both polygons must be convex, with their vertices in order (either winding).
+// Polygons with fewer than 3 vertices are never overlapping.
+func PolygonsMTV(a, b []Vec) (Vec, bool) {
+	if len(a) < 3 || len(b) < 3 {
+		return Vec{}, false
+	}
+	var (
+		bestAxis  Vec
+		bestDepth float32
+		found     bool
+	)
+	for _, poly := range [2][]Vec{a, b} {
+		for i, p := range poly {
+			edge := poly[(i+1)%len(poly)].Sub(p)
+			length := sqrtPrecise(edge.RadiusSquared())
+			if length == 0 {
+				continue
+			}
+			axis := edge.Rotate90().Scale(1 / length)
+			minA, maxA := projectPolygon(a, axis)
+			minB, maxB := projectPolygon(b, axis)
+			depth := min(maxA-minB, maxB-minA)
+			if depth <= 0 {
+				return Vec{}, false
+			}
+			if !found || depth < bestDepth {
+				bestDepth = depth
+				bestAxis = axis
+				// push "a" away from "b", in the direction it is least overlapped
+				if maxA-minB < maxB-minA {
+					bestAxis = axis.Negate()
+				}
+				found = true
+			}
+		}
+	}
+	if !found {
+		return Vec{}, false
+	}
+	return bestAxis.Scale(bestDepth), true
+}
+
+// Projects all vertices of a polygon onto the axis, returning the range
+// of the resulting shadow.
+func projectPolygon(poly []Vec, axis Vec) (min, max float32) {
+	min = poly[0].Dot(axis)
+	max = min
+	for _, p := range poly[1:] {
+		d := p.Dot(axis)
+		if d < min {
+			min = d
+		} else if d > max {
+			max = d
+		}
+	}
+	return min, max
+}
+
+// Mass of an immovable body, such as a wall, for use with [ResolveElastic].
+//
+// An immovable body keeps its velocity in a collision, while the other
+// body bounces off it.
+// This is positive infinity, and any mass that is positive infinity
+// is treated as immovable.
+var InfiniteMass = float32(math.Inf(1))
+
+// Resolves the velocities of two colliding circles in place, using the
+// standard elastic collision formula along the collision normal,
+// i.e the direction from "posA" to "posB".
+//
+// Only the velocity components along the normal are changed, so circles
+// hitting each other at an angle glance off. The circles must already
+// be touching, such as detected by [CircleSweep].
+//
+// The "restitution" is the bounciness, where 1 is a perfectly elastic
+// collision that keeps all energy, and 0 makes the circles stop moving
+// towards each other without bouncing.
+//
+// Masses must be positive. Use [InfiniteMass], or any other positive infinity,
+// for an immovable body.
+// If either mass is zero, negative, or NaN, or if both bodies are immovable,
+// or if the circles are already moving apart, or if "posA" and "posB" are
+// the same position, then the velocities are left unchanged.
+func ResolveElastic(posA Vec, velA *Vec, massA float32, posB Vec, velB *Vec, massB float32, restitution float32) {
+	if !(massA > 0) || !(massB > 0) {
+		return
+	}
+	offset := posB.Sub(posA)
+	distance := sqrtPrecise(offset.RadiusSquared())
+	if distance == 0 {
+		return
+	}
+	normal := offset.Scale(1 / distance)
+	approaching := velA.Sub(*velB).Dot(normal)
+	if approaching <= 0 {
+		return
+	}
+	invMassA := inverseMass(massA)
+	invMassB := inverseMass(massB)
+	if invMassA+invMassB == 0 {
+		return
+	}
+	impulse := (1 + restitution) * approaching / (invMassA + invMassB)
+	*velA = velA.Sub(normal.Scale(impulse * invMassA))
+	*velB = velB.Add(normal.Scale(impulse * invMassB))
+}
+
+// Returns 1/mass, or 0 for an infinite mass.
+func inverseMass(mass float32) float32 {
+	if math.IsInf(float64(mass), 1) {
+		return 0
+	}
+	return 1 / mass
+}
@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSegmentCircleSweep(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		p0, p1  Vec
+		center  Vec
+		radius  float32
+		wantT   float32
+		wantHit bool
+	}{
+		{name: "grazing", p0: V(-10, 5), p1: V(10, 5), center: V(0, 0), radius: 5, wantT: 0.5, wantHit: true},
+		{name: "passing through", p0: V(-10, 0), p1: V(10, 0), center: V(0, 0), radius: 5, wantT: 0.25, wantHit: true},
+		{name: "missing", p0: V(-10, 6), p1: V(10, 6), center: V(0, 0), radius: 5, wantHit: false},
+		{name: "stopping short", p0: V(-10, 0), p1: V(-6, 0), center: V(0, 0), radius: 5, wantHit: false},
+		{name: "moving away", p0: V(-10, 0), p1: V(-20, 0), center: V(0, 0), radius: 5, wantHit: false},
+		{name: "start inside", p0: V(1, 1), p1: V(10, 10), center: V(0, 0), radius: 5, wantT: 0, wantHit: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotT, gotHit := SegmentCircleSweep(test.p0, test.p1, test.center, test.radius)
+			if gotHit != test.wantHit || !EqualApprox(gotT, test.wantT) {
+				t.Errorf("SegmentCircleSweep(%v, %v, %v, %v)\nwant: %v, %t\ngot:  %v, %t",
+					test.p0, test.p1, test.center, test.radius, test.wantT, test.wantHit, gotT, gotHit)
+			}
+		})
+	}
+}
+
+func TestCircleSweep(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		posA, velA Vec
+		radA       float32
+		posB, velB Vec
+		radB       float32
+		delta      float32
+		wantT      float32
+		wantHit    bool
+	}{
+		{
+			name: "head-on",
+			posA: V(0, 0), velA: V(10, 0), radA: 1,
+			posB: V(20, 0), velB: V(-10, 0), radB: 1,
+			delta: 1, wantT: 0.9, wantHit: true,
+		},
+		{
+			name: "head-on too slow",
+			posA: V(0, 0), velA: V(10, 0), radA: 1,
+			posB: V(20, 0), velB: V(-10, 0), radB: 1,
+			delta: 0.5, wantHit: false,
+		},
+		{
+			name: "near miss",
+			posA: V(0, 0), velA: V(10, 0), radA: 1,
+			posB: V(10, 2.5), velB: V(0, 0), radB: 1,
+			delta: 2, wantHit: false,
+		},
+		{
+			name: "already overlapping",
+			posA: V(0, 0), velA: V(10, 0), radA: 2,
+			posB: V(1, 1), velB: V(0, 0), radB: 2,
+			delta: 1, wantT: 0, wantHit: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotT, gotHit := CircleSweep(test.posA, test.velA, test.radA, test.posB, test.velB, test.radB, test.delta)
+			if gotHit != test.wantHit || !EqualApprox(gotT, test.wantT) {
+				t.Errorf("CircleSweep(...)\nwant: %v, %t\ngot:  %v, %t",
+					test.wantT, test.wantHit, gotT, gotHit)
+			}
+		})
+	}
+}
+
+func TestPolygonsMTV(t *testing.T) {
+	t.Parallel()
+	square := func(x, y, size float32) []Vec {
+		return []Vec{V(x, y), V(x+size, y), V(x+size, y+size), V(x, y+size)}
+	}
+	tests := []struct {
+		name    string
+		a, b    []Vec
+		wantMTV Vec
+		wantHit bool
+	}{
+		{name: "overlap from left", a: square(0, 0, 10), b: square(8, 1, 10), wantMTV: V(-2, 0), wantHit: true},
+		{name: "overlap from below", a: square(1, 7, 10), b: square(0, 0, 10), wantMTV: V(0, 3), wantHit: true},
+		{name: "triangle into square", a: []Vec{V(5, -2), V(8, 3), V(2, 3)}, b: square(0, 0, 10), wantMTV: V(0, -3), wantHit: true},
+		{name: "touching", a: square(0, 0, 10), b: square(10, 0, 10), wantHit: false},
+		{name: "separated", a: square(0, 0, 10), b: square(20, 20, 10), wantHit: false},
+		{name: "separated diagonal", a: []Vec{V(0, 0), V(10, 0), V(0, 10)}, b: square(6, 6, 4), wantHit: false},
+		{name: "degenerate", a: []Vec{V(0, 0), V(10, 10)}, b: square(0, 0, 10), wantHit: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotMTV, gotHit := PolygonsMTV(test.a, test.b)
+			if gotHit != test.wantHit || !gotMTV.EqualApprox(test.wantMTV) {
+				t.Fatalf("PolygonsMTV(%v, %v)\nwant: %v, %t\ngot:  %v, %t",
+					test.a, test.b, test.wantMTV, test.wantHit, gotMTV, gotHit)
+			}
+			if !gotHit {
+				return
+			}
+			moved := make([]Vec, len(test.a))
+			for i, p := range test.a {
+				moved[i] = p.Add(gotMTV)
+			}
+			if _, hit := PolygonsMTV(moved, test.b); hit {
+				t.Errorf("PolygonsMTV(%v, %v): still overlapping after resolving", moved, test.b)
+			}
+		})
+	}
+}
+
+func TestResolveElastic(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		posA, velA   Vec
+		massA        float32
+		posB, velB   Vec
+		massB        float32
+		restitution  float32
+		wantA, wantB Vec
+	}{
+		{
+			name: "head-on equal mass swaps velocities",
+			posA: V(0, 0), velA: V(10, 0), massA: 1,
+			posB: V(2, 0), velB: V(-5, 0), massB: 1,
+			restitution: 1,
+			wantA:       V(-5, 0), wantB: V(10, 0),
+		},
+		{
+			name: "moving into resting equal mass",
+			posA: V(0, 0), velA: V(10, 0), massA: 2,
+			posB: V(2, 0), velB: V(0, 0), massB: 2,
+			restitution: 1,
+			wantA:       V(0, 0), wantB: V(10, 0),
+		},
+		{
+			name: "inelastic equal mass",
+			posA: V(0, 0), velA: V(10, 0), massA: 1,
+			posB: V(2, 0), velB: V(0, 0), massB: 1,
+			restitution: 0,
+			wantA:       V(5, 0), wantB: V(5, 0),
+		},
+		{
+			name: "heavier body barely slows",
+			posA: V(0, 0), velA: V(10, 0), massA: 3,
+			posB: V(2, 0), velB: V(0, 0), massB: 1,
+			restitution: 1,
+			wantA:       V(5, 0), wantB: V(15, 0),
+		},
+		{
+			name: "bounce off immovable wall",
+			posA: V(0, 0), velA: V(0, 8), massA: 1,
+			posB: V(0, 2), velB: V(0, 0), massB: InfiniteMass,
+			restitution: 1,
+			wantA:       V(0, -8), wantB: V(0, 0),
+		},
+		{
+			name: "bounce off wall with infinite mass",
+			posA: V(0, 0), velA: V(8, 0), massA: 1,
+			posB: V(2, 0), velB: V(0, 0), massB: float32(math.Inf(1)),
+			restitution: 1,
+			wantA:       V(-8, 0), wantB: V(0, 0),
+		},
+		{
+			name: "half bounce off immovable wall",
+			posA: V(0, 0), velA: V(0, 8), massA: 1,
+			posB: V(0, 2), velB: V(0, 0), massB: InfiniteMass,
+			restitution: 0.5,
+			wantA:       V(0, -4), wantB: V(0, 0),
+		},
+		{
+			name: "glancing keeps tangential velocity",
+			posA: V(0, 0), velA: V(3, 4), massA: 1,
+			posB: V(0, 2), velB: V(0, 0), massB: InfiniteMass,
+			restitution: 1,
+			wantA:       V(3, -4), wantB: V(0, 0),
+		},
+		{
+			name: "moving apart is unchanged",
+			posA: V(0, 0), velA: V(-10, 0), massA: 1,
+			posB: V(2, 0), velB: V(10, 0), massB: 1,
+			restitution: 1,
+			wantA:       V(-10, 0), wantB: V(10, 0),
+		},
+		{
+			name: "both immovable is unchanged",
+			posA: V(0, 0), velA: V(10, 0), massA: InfiniteMass,
+			posB: V(2, 0), velB: V(-10, 0), massB: InfiniteMass,
+			restitution: 1,
+			wantA:       V(10, 0), wantB: V(-10, 0),
+		},
+		{
+			name: "zero mass is unchanged",
+			posA: V(0, 0), velA: V(10, 0), massA: 0,
+			posB: V(2, 0), velB: V(-10, 0), massB: 1,
+			restitution: 1,
+			wantA:       V(10, 0), wantB: V(-10, 0),
+		},
+		{
+			name: "negative mass is unchanged",
+			posA: V(0, 0), velA: V(10, 0), massA: 1,
+			posB: V(2, 0), velB: V(-10, 0), massB: -1,
+			restitution: 1,
+			wantA:       V(10, 0), wantB: V(-10, 0),
+		},
+		{
+			name: "NaN mass is unchanged",
+			posA: V(0, 0), velA: V(10, 0), massA: float32(math.NaN()),
+			posB: V(2, 0), velB: V(-10, 0), massB: 1,
+			restitution: 1,
+			wantA:       V(10, 0), wantB: V(-10, 0),
+		},
+		{
+			name: "same position is unchanged",
+			posA: V(1, 1), velA: V(10, 0), massA: 1,
+			posB: V(1, 1), velB: V(-10, 0), massB: 1,
+			restitution: 1,
+			wantA:       V(10, 0), wantB: V(-10, 0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			velA, velB := test.velA, test.velB
+			ResolveElastic(test.posA, &velA, test.massA, test.posB, &velB, test.massB, test.restitution)
+			if !velA.EqualApprox(test.wantA) || !velB.EqualApprox(test.wantB) {
+				t.Errorf("ResolveElastic(...)\nwant: velA=%v velB=%v\ngot:  velA=%v velB=%v", test.wantA, test.wantB, velA, velB)
+			}
+		})
+	}
+}
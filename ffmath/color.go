@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"slices"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Linearly interpolates between two colors by the factor defined in "weight",
+// where each channel is interpolated separately and rounded to the nearest value.
+//
+// The weight is clamped to the range [0, 1], as the channels can't go
+// outside the range [0, 255].
+//
+// Firefly Zero draws using a palette of 16 colors, so use [firefly.SetColor]
+// to apply the resulting color to a palette entry.
+func LerpRGB(from, to firefly.RGB, weight float32) firefly.RGB {
+	weight = Clamp01(weight)
+	return firefly.RGB{
+		R: lerpChannel(from.R, to.R, weight),
+		G: lerpChannel(from.G, to.G, weight),
+		B: lerpChannel(from.B, to.B, weight),
+	}
+}
+
+func lerpChannel(from, to uint8, weight float32) uint8 {
+	return uint8(tinymath.Round(Lerp(float32(from), float32(to), weight)))
+}
+
+// A color at a position in a [Gradient].
+type GradientStop struct {
+	// Position of the stop, usually in the range [0, 1].
+	Position float32
+	Color    firefly.RGB
+}
+
+// Multi-stop color gradient, such as for health bars, heat maps,
+// and sky colors over the day.
+type Gradient struct {
+	stops []GradientStop
+}
+
+// Creates a new [Gradient] from the given stops.
+//
+// The stops are copied and sorted by their position,
+// so they can be given in any order.
+func NewGradient(stops ...GradientStop) Gradient {
+	sorted := slices.Clone(stops)
+	slices.SortStableFunc(sorted, func(a, b GradientStop) int {
+		switch {
+		case a.Position < b.Position:
+			return -1
+		case a.Position > b.Position:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return Gradient{stops: sorted}
+}
+
+// Get the interpolated color at "t".
+//
+// Positions before the first stop get the first stop's color, and positions
+// after the last stop get the last stop's color.
+// Positions between two stops are linearly interpolated using [LerpRGB].
+// Returns black if the gradient has no stops.
+func (g Gradient) Sample(t float32) firefly.RGB {
+	if len(g.stops) == 0 {
+		return firefly.RGB{}
+	}
+	if t <= g.stops[0].Position {
+		return g.stops[0].Color
+	}
+	for i := 1; i < len(g.stops); i++ {
+		next := g.stops[i]
+		if t > next.Position {
+			continue
+		}
+		prev := g.stops[i-1]
+		if next.Position == prev.Position {
+			return next.Color
+		}
+		weight := (t - prev.Position) / (next.Position - prev.Position)
+		return LerpRGB(prev.Color, next.Color, weight)
+	}
+	return g.stops[len(g.stops)-1].Color
+}
+
+// Creates a color from hue, saturation, and value (HSV),
+// which makes it easy to vary the hue, such as for rainbow effects.
+//
+// The hue "h" is in degrees, and is wrapped into the range [0, 360).
+// Saturation "s" and value "v" are clamped to the range [0, 1].
+//
+// See [ToHSV] for the reverse conversion.
+func HSV(h, s, v float32) firefly.RGB {
+	h = Repeat(h, 360)
+	s = Clamp01(s)
+	v = Clamp01(v)
+	chroma := v * s
+	sector := h / 60
+	x := chroma * (1 - tinymath.Abs(Repeat(sector, 2)-1))
+	var r, g, b float32
+	switch int(sector) {
+	case 0:
+		r, g, b = chroma, x, 0
+	case 1:
+		r, g, b = x, chroma, 0
+	case 2:
+		r, g, b = 0, chroma, x
+	case 3:
+		r, g, b = 0, x, chroma
+	case 4:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+	m := v - chroma
+	return firefly.RGB{
+		R: uint8(tinymath.Round((r + m) * 255)),
+		G: uint8(tinymath.Round((g + m) * 255)),
+		B: uint8(tinymath.Round((b + m) * 255)),
+	}
+}
+
+// Converts a color to hue, saturation, and value (HSV).
+//
+// The hue "h" is in degrees in the range [0, 360), and saturation "s" and
+// value "v" are in the range [0, 1].
+// For grayscale colors (including black and white) the hue is undefined,
+// and is returned as 0 together with a saturation of 0.
+//
+// See [HSV] for the reverse conversion.
+func ToHSV(c firefly.RGB) (h, s, v float32) {
+	r := float32(c.R) / 255
+	g := float32(c.G) / 255
+	b := float32(c.B) / 255
+	maxC := max(r, g, b)
+	minC := min(r, g, b)
+	chroma := maxC - minC
+	v = maxC
+	if chroma == 0 {
+		return 0, 0, v
+	}
+	s = chroma / maxC
+	switch maxC {
+	case r:
+		h = 60 * Repeat((g-b)/chroma, 6)
+	case g:
+		h = 60 * ((b-r)/chroma + 2)
+	default:
+		h = 60 * ((r-g)/chroma + 4)
+	}
+	return Repeat(h, 360), s, v
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"testing"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+)
+
+func TestGradientTwoStops(t *testing.T) {
+	t.Parallel()
+	g := NewGradient(
+		GradientStop{Position: 0, Color: firefly.NewRGB(0, 0, 0)},
+		GradientStop{Position: 1, Color: firefly.NewRGB(200, 100, 255)},
+	)
+	tests := []struct {
+		t    float32
+		want firefly.RGB
+	}{
+		{t: 0, want: firefly.NewRGB(0, 0, 0)},
+		{t: 1, want: firefly.NewRGB(200, 100, 255)},
+		{t: 0.5, want: firefly.NewRGB(100, 50, 128)},
+		{t: -1, want: firefly.NewRGB(0, 0, 0)},
+		{t: 2, want: firefly.NewRGB(200, 100, 255)},
+	}
+
+	for _, test := range tests {
+		if got := g.Sample(test.t); got != test.want {
+			t.Errorf("Sample(%v): want %v, got %v", test.t, test.want, got)
+		}
+	}
+}
+
+func TestGradientThreeStops(t *testing.T) {
+	t.Parallel()
+	// given out of order, to check that they are sorted
+	g := NewGradient(
+		GradientStop{Position: 1, Color: firefly.NewRGB(0, 255, 0)},
+		GradientStop{Position: 0, Color: firefly.NewRGB(255, 0, 0)},
+		GradientStop{Position: 0.25, Color: firefly.NewRGB(255, 255, 0)},
+	)
+	tests := []struct {
+		t    float32
+		want firefly.RGB
+	}{
+		{t: 0.125, want: firefly.NewRGB(255, 128, 0)},
+		{t: 0.25, want: firefly.NewRGB(255, 255, 0)},
+		{t: 0.625, want: firefly.NewRGB(128, 255, 0)},
+		{t: 0.8125, want: firefly.NewRGB(64, 255, 0)},
+	}
+
+	for _, test := range tests {
+		if got := g.Sample(test.t); got != test.want {
+			t.Errorf("Sample(%v): want %v, got %v", test.t, test.want, got)
+		}
+	}
+
+	if got := (Gradient{}).Sample(0.5); got != (firefly.RGB{}) {
+		t.Errorf("empty Sample(0.5): want black, got %v", got)
+	}
+}
+
+func TestHSV(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		h, s, v float32
+		want    firefly.RGB
+	}{
+		{name: "red", h: 0, s: 1, v: 1, want: firefly.NewRGB(255, 0, 0)},
+		{name: "yellow", h: 60, s: 1, v: 1, want: firefly.NewRGB(255, 255, 0)},
+		{name: "green", h: 120, s: 1, v: 1, want: firefly.NewRGB(0, 255, 0)},
+		{name: "cyan", h: 180, s: 1, v: 1, want: firefly.NewRGB(0, 255, 255)},
+		{name: "blue", h: 240, s: 1, v: 1, want: firefly.NewRGB(0, 0, 255)},
+		{name: "magenta", h: 300, s: 1, v: 1, want: firefly.NewRGB(255, 0, 255)},
+		{name: "wrapped red", h: 360, s: 1, v: 1, want: firefly.NewRGB(255, 0, 0)},
+		{name: "negative hue", h: -120, s: 1, v: 1, want: firefly.NewRGB(0, 0, 255)},
+		{name: "white", h: 123, s: 0, v: 1, want: firefly.NewRGB(255, 255, 255)},
+		{name: "black", h: 0, s: 1, v: 0, want: firefly.NewRGB(0, 0, 0)},
+		{name: "dark orange", h: 30, s: 1, v: 0.5, want: firefly.NewRGB(128, 64, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := HSV(test.h, test.s, test.v); got != test.want {
+				t.Errorf("HSV(%v, %v, %v): want %v, got %v", test.h, test.s, test.v, test.want, got)
+			}
+		})
+	}
+}
+
+func TestToHSV(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		c            firefly.RGB
+		wantH        float32
+		wantS, wantV float32
+	}{
+		{c: firefly.NewRGB(255, 0, 0), wantH: 0, wantS: 1, wantV: 1},
+		{c: firefly.NewRGB(0, 255, 0), wantH: 120, wantS: 1, wantV: 1},
+		{c: firefly.NewRGB(0, 0, 255), wantH: 240, wantS: 1, wantV: 1},
+		{c: firefly.NewRGB(255, 0, 255), wantH: 300, wantS: 1, wantV: 1},
+		// grayscale has undefined hue
+		{c: firefly.NewRGB(0, 0, 0), wantH: 0, wantS: 0, wantV: 0},
+		{c: firefly.NewRGB(255, 255, 255), wantH: 0, wantS: 0, wantV: 1},
+		{c: firefly.NewRGB(51, 51, 51), wantH: 0, wantS: 0, wantV: 0.2},
+	}
+
+	for _, test := range tests {
+		h, s, v := ToHSV(test.c)
+		if !EqualApprox(h, test.wantH) || !EqualApprox(s, test.wantS) || !EqualApprox(v, test.wantV) {
+			t.Errorf("ToHSV(%v): want %v, %v, %v, got %v, %v, %v", test.c, test.wantH, test.wantS, test.wantV, h, s, v)
+		}
+	}
+}
+
+func TestHSVRoundTrip(t *testing.T) {
+	t.Parallel()
+	colors := []firefly.RGB{
+		firefly.NewRGB(255, 0, 0),
+		firefly.NewRGB(26, 28, 44),
+		firefly.NewRGB(93, 39, 93),
+		firefly.NewRGB(177, 62, 83),
+		firefly.NewRGB(239, 125, 87),
+		firefly.NewRGB(255, 205, 117),
+		firefly.NewRGB(167, 240, 112),
+		firefly.NewRGB(56, 183, 100),
+		firefly.NewRGB(37, 113, 121),
+		firefly.NewRGB(148, 176, 194),
+		firefly.NewRGB(128, 128, 128),
+	}
+
+	for _, c := range colors {
+		h, s, v := ToHSV(c)
+		if got := HSV(h, s, v); got != c {
+			t.Errorf("HSV(ToHSV(%v)): want %v, got %v (hsv: %v, %v, %v)", c, c, got, h, s, v)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Evaluates a Catmull-Rom spline that passes through all of the given points.
+//
+// The "t" parameter ranges over [0, 1] across the whole path, where each
+// segment between two consecutive points gets an equal share of the range.
+// In other words, for N points the spline passes through points[i]
+// at t = i / (N-1). Values outside [0, 1] are clamped.
+//
+// The tangents at the endpoints are calculated by duplicating the first and
+// last points, making the spline start and end at the first and last points.
+//
+// Returns the zero [Vec] if the slice is empty.
+func CatmullRomSpline(points []Vec, t float32) Vec {
+	switch len(points) {
+	case 0:
+		return Vec{}
+	case 1:
+		return points[0]
+	}
+	segments := len(points) - 1
+	scaled := Clamp01(t) * float32(segments)
+	i := int(scaled)
+	if i >= segments {
+		i = segments - 1
+	}
+	weight := scaled - float32(i)
+
+	pre := points[max(i-1, 0)]
+	from := points[i]
+	to := points[i+1]
+	post := points[min(i+2, segments)]
+	return Vec{
+		X: CubicInterpolate(pre.X, from.X, to.X, post.X, weight),
+		Y: CubicInterpolate(pre.Y, from.Y, to.Y, post.Y, weight),
+	}
+}
+
+// Samples a position from an ordered list of keyframes at the normalized time "t",
+// by linearly interpolating between the two keyframes surrounding "t".
+//
+// The keyframes are spread out evenly, the same way as the points in
+// [CatmullRomSpline], so for N keyframes the result is frames[i]
+// at t = i / (N-1). Values outside [0, 1] are clamped.
+// This is cheaper than [CatmullRomSpline], but the motion is not smooth
+// when passing through the keyframes.
+//
+// Returns the zero [Vec] if the slice is empty.
+func SampleKeyframes(frames []Vec, t float32) Vec {
+	switch len(frames) {
+	case 0:
+		return Vec{}
+	case 1:
+		return frames[0]
+	}
+	segments := len(frames) - 1
+	scaled := Clamp01(t) * float32(segments)
+	i := int(scaled)
+	if i >= segments {
+		i = segments - 1
+	}
+	return frames[i].Lerp(frames[i+1], scaled-float32(i))
+}
+
+// Lookup table for traveling along a curve at constant speed.
+//
+// Curves such as [CatmullRomSpline] travel at non-constant speed in their
+// "t" parameter. This table maps distances along the curve back to "t",
+// allowing objects to move along the curve at a constant speed.
+//
+// Create it with [NewArcLengthTable].
+type ArcLengthTable struct {
+	sample    func(t float32) Vec
+	distances []float32
+}
+
+// Creates a new [ArcLengthTable] by sampling the curve at "segments"+1 evenly
+// spaced "t" values in the range [0, 1].
+//
+// More segments gives a more accurate table, at the cost of more memory
+// and more calls to "sample".
+//
+// It panics if segments <= 0.
+func NewArcLengthTable(sample func(t float32) Vec, segments int) ArcLengthTable {
+	if segments <= 0 {
+		panic("invalid argument to NewArcLengthTable")
+	}
+	distances := make([]float32, segments+1)
+	prev := sample(0)
+	for i := 1; i <= segments; i++ {
+		next := sample(float32(i) / float32(segments))
+		diff := next.Sub(prev)
+		distances[i] = distances[i-1] + sqrtPrecise(diff.RadiusSquared())
+		prev = next
+	}
+	return ArcLengthTable{sample: sample, distances: distances}
+}
+
+// Total approximated length of the curve.
+func (a ArcLengthTable) Length() float32 {
+	if len(a.distances) == 0 {
+		return 0
+	}
+	return a.distances[len(a.distances)-1]
+}
+
+// Position on the curve at the given distance from the start of the curve.
+//
+// The distance is clamped to [0, [ArcLengthTable.Length]].
+func (a ArcLengthTable) PointAtDistance(d float32) Vec {
+	return a.sample(a.weightAtDistance(d))
+}
+
+func (a ArcLengthTable) weightAtDistance(d float32) float32 {
+	segments := len(a.distances) - 1
+	if segments <= 0 || d <= 0 {
+		return 0
+	}
+	if d >= a.Length() {
+		return 1
+	}
+	// binary search for the first distance that is >= d
+	lo, hi := 1, segments
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if a.distances[mid] < d {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	segmentWeight := InverseLerp(a.distances[lo-1], a.distances[lo], d)
+	return (float32(lo-1) + segmentWeight) / float32(segments)
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestCatmullRomSpline(t *testing.T) {
+	t.Parallel()
+	points := []Vec{V(0, 0), V(10, 5), V(20, -5), V(30, 0)}
+
+	for i, want := range points {
+		weight := float32(i) / float32(len(points)-1)
+		got := CatmullRomSpline(points, weight)
+		if !got.EqualApprox(want) {
+			t.Errorf("CatmullRomSpline(points, %f)\nwant: %v\ngot:  %v", weight, want, got)
+		}
+	}
+
+	if got := CatmullRomSpline(points, -1); !got.EqualApprox(points[0]) {
+		t.Errorf("CatmullRomSpline(points, -1)\nwant: %v\ngot:  %v", points[0], got)
+	}
+	if got := CatmullRomSpline(points, 2); !got.EqualApprox(points[3]) {
+		t.Errorf("CatmullRomSpline(points, 2)\nwant: %v\ngot:  %v", points[3], got)
+	}
+	if got := CatmullRomSpline(nil, 0.5); got != (Vec{}) {
+		t.Errorf("CatmullRomSpline(nil, 0.5)\nwant: %v\ngot:  %v", Vec{}, got)
+	}
+}
+
+func TestArcLengthTable(t *testing.T) {
+	t.Parallel()
+	points := []Vec{V(0, 0), V(30, 20), V(60, 20), V(90, 0)}
+	table := NewArcLengthTable(func(t float32) Vec {
+		return CatmullRomSpline(points, t)
+	}, 200)
+
+	const steps = 10
+	stepLength := table.Length() / steps
+	prev := table.PointAtDistance(0)
+	for i := 1; i <= steps; i++ {
+		next := table.PointAtDistance(float32(i) * stepLength)
+		dist := sqrtPrecise(next.DistanceToSquared(prev))
+		// the distance between points is the chord, which is slightly shorter than the arc
+		if dist > stepLength*1.01 || dist < stepLength*0.9 {
+			t.Errorf("PointAtDistance step %d\nwant: ~%f\ngot:  %f", i, stepLength, dist)
+		}
+		prev = next
+	}
+
+	if got := table.PointAtDistance(table.Length() + 10); !got.EqualApprox(points[3]) {
+		t.Errorf("PointAtDistance(beyond end)\nwant: %v\ngot:  %v", points[3], got)
+	}
+	if got := table.PointAtDistance(-10); !got.EqualApprox(points[0]) {
+		t.Errorf("PointAtDistance(before start)\nwant: %v\ngot:  %v", points[0], got)
+	}
+}
+
+func TestSampleKeyframes(t *testing.T) {
+	t.Parallel()
+	frames := []Vec{V(0, 0), V(10, 0), V(10, 20), V(-10, 20), V(-10, 0)}
+
+	for i, frame := range frames {
+		weight := float32(i) / float32(len(frames)-1)
+		if got := SampleKeyframes(frames, weight); !got.EqualApprox(frame) {
+			t.Errorf("SampleKeyframes(frames, %v): want keyframe %d %v, got %v", weight, i, frame, got)
+		}
+	}
+
+	tests := []struct {
+		t    float32
+		want Vec
+	}{
+		{t: 0.125, want: V(5, 0)},
+		{t: 0.3125, want: V(10, 5)},
+		{t: 0.375, want: V(10, 10)},
+		{t: 0.625, want: V(0, 20)},
+		{t: 0.9, want: V(-10, 8)},
+		{t: -1, want: V(0, 0)},
+		{t: 2, want: V(-10, 0)},
+	}
+
+	for _, test := range tests {
+		if got := SampleKeyframes(frames, test.t); !got.EqualApprox(test.want) {
+			t.Errorf("SampleKeyframes(frames, %v): want %v, got %v", test.t, test.want, got)
+		}
+	}
+
+	if got := SampleKeyframes(nil, 0.5); got != (Vec{}) {
+		t.Errorf("SampleKeyframes(nil, 0.5): want {0,0}, got %v", got)
+	}
+	if got := SampleKeyframes([]Vec{V(3, 4)}, 0.5); got != V(3, 4) {
+		t.Errorf("SampleKeyframes(single, 0.5): want %v, got %v", V(3, 4), got)
+	}
+}
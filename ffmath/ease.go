@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "github.com/orsinium-labs/tinymath"
+
+// Easing functions take a progress "t" in the range [0, 1] and return the
+// eased progress, where 0 maps to 0 and 1 maps to 1.
+//
+// Use them to shape the "weight" passed to functions such as [Lerp]:
+//
+//	pos := start.Lerp(end, EaseOutQuad(t))
+//
+// The curves are the commonly used ones, as listed on https://easings.net.
+
+// No easing, returns "t" unchanged.
+func EaseLinear(t float32) float32 { return t }
+
+// Quadratic easing that starts slow.
+func EaseInQuad(t float32) float32 { return t * t }
+
+// Quadratic easing that ends slow.
+func EaseOutQuad(t float32) float32 { return 1 - (1-t)*(1-t) }
+
+// Quadratic easing that starts and ends slow.
+func EaseInOutQuad(t float32) float32 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	u := -2*t + 2
+	return 1 - u*u/2
+}
+
+// Cubic easing that starts slow.
+func EaseInCubic(t float32) float32 { return t * t * t }
+
+// Cubic easing that ends slow.
+func EaseOutCubic(t float32) float32 {
+	u := 1 - t
+	return 1 - u*u*u
+}
+
+// Cubic easing that starts and ends slow.
+func EaseInOutCubic(t float32) float32 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	u := -2*t + 2
+	return 1 - u*u*u/2
+}
+
+// Sinusoidal easing that starts slow.
+//
+// Uses [tinymath] for faster but less accurate calculation, with a max error of 0.002.
+func EaseInSine(t float32) float32 { return 1 - tinymath.Cos(t*tinymath.Pi/2) }
+
+// Sinusoidal easing that ends slow.
+//
+// Uses [tinymath] for faster but less accurate calculation, with a max error of 0.002.
+func EaseOutSine(t float32) float32 { return tinymath.Sin(t * tinymath.Pi / 2) }
+
+// Sinusoidal easing that starts and ends slow.
+//
+// Uses [tinymath] for faster but less accurate calculation, with a max error of 0.002.
+func EaseInOutSine(t float32) float32 { return (1 - tinymath.Cos(t*tinymath.Pi)) / 2 }
+
+// Easing that bounces against the start, like a ball dropped in reverse.
+func EaseInBounce(t float32) float32 { return 1 - EaseOutBounce(1-t) }
+
+// Easing that bounces against the end, like a ball dropped onto the floor.
+func EaseOutBounce(t float32) float32 {
+	const (
+		n1 = 7.5625
+		d1 = 2.75
+	)
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	default:
+		t -= 2.625 / d1
+		return n1*t*t + 0.984375
+	}
+}
+
+// Easing that bounces against both the start and the end.
+func EaseInOutBounce(t float32) float32 {
+	if t < 0.5 {
+		return (1 - EaseOutBounce(1-2*t)) / 2
+	}
+	return (1 + EaseOutBounce(2*t-1)) / 2
+}
+
+var easeByName = map[string]func(float32) float32{
+	"linear":        EaseLinear,
+	"in-quad":       EaseInQuad,
+	"out-quad":      EaseOutQuad,
+	"in-out-quad":   EaseInOutQuad,
+	"in-cubic":      EaseInCubic,
+	"out-cubic":     EaseOutCubic,
+	"in-out-cubic":  EaseInOutCubic,
+	"in-sine":       EaseInSine,
+	"out-sine":      EaseOutSine,
+	"in-out-sine":   EaseInOutSine,
+	"in-bounce":     EaseInBounce,
+	"out-bounce":    EaseOutBounce,
+	"in-out-bounce": EaseInOutBounce,
+}
+
+// Looks up an easing function by its name, for data-driven animations.
+//
+// Names are in lowercase kebab-case, such as "linear", "in-quad", "out-cubic",
+// "in-out-sine", or "out-bounce".
+// Returns false if there is no easing function with that name.
+func EaseByName(name string) (func(float32) float32, bool) {
+	f, ok := easeByName[name]
+	return f, ok
+}
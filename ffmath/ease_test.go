@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestEaseEndpoints(t *testing.T) {
+	t.Parallel()
+	for name, ease := range easeByName {
+		// tinymath.Cos has a max error of 0.002
+		if got := ease(0); Abs(got) > 0.002 {
+			t.Errorf("%s(0): want 0, got %v", name, got)
+		}
+		if got := ease(1); Abs(got-1) > 0.002 {
+			t.Errorf("%s(1): want 1, got %v", name, got)
+		}
+	}
+}
+
+func TestEaseByName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		t      float32
+		want   float32
+		wantOK bool
+	}{
+		{name: "linear", t: 0.25, want: 0.25, wantOK: true},
+		{name: "in-quad", t: 0.5, want: 0.25, wantOK: true},
+		{name: "out-quad", t: 0.5, want: 0.75, wantOK: true},
+		{name: "in-out-cubic", t: 0.5, want: 0.5, wantOK: true},
+		{name: "out-bounce", t: 0.5, want: 0.765625, wantOK: true},
+		{name: "unknown", wantOK: false},
+		{name: "In-Quad", wantOK: false},
+		{name: "", wantOK: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ease, ok := EaseByName(test.name)
+			if ok != test.wantOK {
+				t.Fatalf("EaseByName(%q): want ok=%t, got ok=%t", test.name, test.wantOK, ok)
+			}
+			if !ok {
+				if ease != nil {
+					t.Errorf("EaseByName(%q): want nil func for unknown name", test.name)
+				}
+				return
+			}
+			if got := ease(test.t); !EqualApprox(got, test.want) {
+				t.Errorf("EaseByName(%q)(%v): want %v, got %v", test.name, test.t, test.want, got)
+			}
+		})
+	}
+}
@@ -20,16 +20,32 @@ const (
 	RadToDeg = 360 / tinymath.Tau
 	// Multiply a number by this factor to convert it from a degree to a radian.
 	DegToRad = tinymath.Tau / 360
-	// Smallest rounding error used in functions like [EqualApprox] and [IsZeroApprox]
+	// Default smallest rounding error used in functions like [EqualApprox] and [IsZeroApprox].
+	//
+	// See [DefaultEpsilon] to change the value used.
 	Epsilon = 0.00001
 )
 
+// Smallest rounding error used in functions like [EqualApprox] and [IsZeroApprox].
+//
+// Defaults to [Epsilon], but can be changed to fit the precision needed by the game,
+// e.g a larger value for pixel-art games.
+//
+// Changing this value is not safe for concurrent use, so it should only
+// be changed once at startup before calling any other functions in this package.
+var DefaultEpsilon float32 = Epsilon
+
 type Number interface {
 	int | int8 | int16 | int32 | int64 |
 		uint | uint8 | uint16 | uint32 | uint64 | uintptr |
 		float32 | float64
 }
 
+type Integer interface {
+	int | int8 | int16 | int32 | int64 |
+		uint | uint8 | uint16 | uint32 | uint64 | uintptr
+}
+
 // Returns value clamped between minimum and maximum.
 //
 //   - If value is less than minimum, then you get minimum
@@ -45,6 +61,61 @@ func Clamp[T cmp.Ordered](val, minimum, maximum T) T {
 	}
 }
 
+// Returns value clamped between minimum and maximum, same as [Clamp],
+// and which bound was hit, such as to play a bump sound only on the side that was hit.
+//
+//   - -1 if value was less than minimum, and minimum was returned
+//   - 0 if value was within the range (inclusive), and value was returned
+//   - +1 if value was more than maximum, and maximum was returned
+//
+// A value that is equal to a bound is not considered clamped.
+func ClampReport[T cmp.Ordered](val, minimum, maximum T) (T, int) {
+	switch {
+	case val < minimum:
+		return minimum, -1
+	case val > maximum:
+		return maximum, 1
+	default:
+		return val, 0
+	}
+}
+
+// Returns the smaller of two floats, ignoring NaN.
+//
+// Unlike the builtin min, which returns NaN if any operand is NaN,
+// this returns the other operand if only one of them is NaN.
+// NaN is only returned if both operands are NaN.
+func MinFloat[T ~float32 | ~float64](a, b T) T {
+	switch {
+	case a != a:
+		return b
+	case b != b:
+		return a
+	case b < a:
+		return b
+	default:
+		return a
+	}
+}
+
+// Returns the larger of two floats, ignoring NaN.
+//
+// Unlike the builtin max, which returns NaN if any operand is NaN,
+// this returns the other operand if only one of them is NaN.
+// NaN is only returned if both operands are NaN.
+func MaxFloat[T ~float32 | ~float64](a, b T) T {
+	switch {
+	case a != a:
+		return b
+	case b != b:
+		return a
+	case b > a:
+		return b
+	default:
+		return a
+	}
+}
+
 // Returns value clamped between 0 and 1.
 //
 //   - If value is less than 0, then you get 0
@@ -60,21 +131,155 @@ func Clamp01[T Number](val T) T {
 	}
 }
 
+// Returns value clamped between 0 and 1.
+//
+// This is an alias of [Clamp01], named after the "saturate" function
+// commonly found in shader languages such as HLSL.
+func Saturate[T Number](val T) T {
+	return Clamp01(val)
+}
+
+// Returns the number of integers in the half-open range [min, max).
+//
+// Returns 0 if the range is empty or inverted (max <= min).
+// Ranges too large to fit in an int, such as the full range of an int64,
+// are capped at [math.MaxInt] instead of overflowing.
+func RangeLen[T Integer](min, max T) int {
+	if max <= min {
+		return 0
+	}
+	// two's complement makes this correct for signed integers too
+	diff := uint64(max) - uint64(min)
+	if diff > math.MaxInt {
+		return math.MaxInt
+	}
+	return int(diff)
+}
+
+// Returns value clamped to the half-open range [min, max),
+// meaning the result is at most max-1.
+//
+// Useful for clamping indexes, e.g ClampToRange(i, 0, len(tiles)).
+//
+// Returns min if the range is empty or inverted (max <= min).
+func ClampToRange[T Integer](v, min, max T) T {
+	switch {
+	case max <= min, v < min:
+		return min
+	case v >= max:
+		return max - 1
+	default:
+		return v
+	}
+}
+
+// Check if the value is in the half-open range [min, max),
+// matching the half-open ranges used in the rest of this package.
+//
+// See [InRangeInclusive] to also include "max".
+func InRange[T cmp.Ordered](val, min, max T) bool {
+	return val >= min && val < max
+}
+
+// Check if the value is in the closed range [min, max].
+//
+// See [InRange] to exclude "max".
+func InRangeInclusive[T cmp.Ordered](val, min, max T) bool {
+	return val >= min && val <= max
+}
+
+// Integer square root, returning floor(sqrt(n)).
+//
+// Uses an integer-only algorithm, which unlike converting to and from floats
+// stays exact for large values, such as for circular ranges on a tile map.
+//
+// Returns 0 for negative values.
+func ISqrt[T Integer](n T) T {
+	if n <= 0 {
+		return 0
+	}
+	x := uint64(n)
+	var result uint64
+	// highest power of 4 that is <= x
+	bit := uint64(1) << 62
+	for bit > x {
+		bit >>= 2
+	}
+	for bit != 0 {
+		if x >= result+bit {
+			x -= result + bit
+			result = result>>1 + bit
+		} else {
+			result >>= 1
+		}
+		bit >>= 2
+	}
+	return T(result)
+}
+
 // Moves "start" towards "end" by "delta" amount.
 //
-// Returned value will not go past "end".
+// Returned value will not go past "end", meaning a "delta" larger than
+// the distance between "start" and "end" results in "end".
 //
 // Use a negative "delta" value to move away from "end".
+// The value then moves by the absolute amount of "delta" in the direction
+// opposite of "end", without any limit.
+// If "start" is equal to "end" then there is no direction to move away from,
+// so "start" is returned as-is.
 //
 // Based on the Godot [move_toward] (licensed under MIT)
 //
 // [move_toward]: https://github.com/godotengine/godot/blob/4.5.1-stable/core/math/math_funcs.h#L591-L596
 func MoveTowards[T Number](start, end, delta T) T {
+	result, _ := MoveTowardsDone(start, end, delta)
+	return result
+}
+
+// Moves "start" towards "end" by "delta" amount, the same way as [MoveTowards],
+// and returns true if the value reached "end".
+//
+// Useful for state machines that should transition when a tween is done.
+func MoveTowardsDone[T Number](start, end, delta T) (T, bool) {
 	if Abs(end-start) <= delta {
-		return end
+		return end, true
 	} else {
-		return start + Sign(end-start)*delta
+		return start + Sign(end-start)*delta, false
+	}
+}
+
+// Moves "value" towards 0 by "rate*delta", stopping at 0 instead of
+// overshooting into the opposite sign, such as for friction on a velocity
+// or fading out a screen shake.
+//
+// This is the same as [MoveTowards](value, 0, rate*delta), named for intent.
+// The "rate" is in units per second and should not be negative.
+func Decay[T Number](value, rate, delta T) T {
+	return MoveTowards(value, 0, rate*delta)
+}
+
+// Frame-rate independent interpolation factor for exponential smoothing,
+// to be used as the "weight" in [Lerp] or [Vec.Lerp]:
+//
+//	pos = pos.Lerp(target, DampFactor(0.1, delta))
+//
+// The "rate" is the fraction of the remaining distance that is closed per
+// reference time, and "delta" is the elapsed time in seconds.
+// The reference time is one frame at 60 FPS (1/60th of a second),
+// which is the frame rate of Firefly Zero.
+// So a rate of 0.1 closes 10% of the distance per 1/60th of a second,
+// regardless of the actual frame rate.
+//
+// Calculated as 1 - (1-rate)^(delta*60).
+// Returns 1 if rate >= 1, and 0 if rate <= 0.
+func DampFactor(rate, delta float32) float32 {
+	if rate >= 1 {
+		return 1
+	}
+	if rate <= 0 {
+		return 0
 	}
+	return float32(1 - math.Pow(float64(1-rate), float64(delta)*60))
 }
 
 // Linear interpolation between two values by the factor defined in "weight".
@@ -93,6 +298,30 @@ func Lerp[T Number](from, to, weight T) T {
 	return from + (to-from)*weight
 }
 
+// Linearly interpolates between two integers by the factor defined in "weight",
+// rounded to the nearest integer, such as for integer health bars.
+//
+// Unlike [Lerp] with integers, which truncates the weight down to 0 or 1,
+// this interpolates with a float weight. Halfway values are rounded away
+// from zero, and the weight is clamped to the range [0, 1],
+// so the result is never outside the range [from, to].
+func LerpInt(from, to int, weight float32) int {
+	weight = Clamp01(weight)
+	return int(math.Round(float64(from) + (float64(to)-float64(from))*float64(weight)))
+}
+
+// Bilinear interpolation between four corner values, such as sampling a height
+// between four tiles.
+//
+// The corners are named by their X and Y position in the unit square,
+// e.g "c10" is the corner at X=1, Y=0.
+// Interpolates along X by "tx" first, and then along Y by "ty".
+//
+// This is the 2D generalization of [Lerp].
+func Bilerp[T Number](c00, c10, c01, c11 T, tx, ty T) T {
+	return Lerp(Lerp(c00, c10, tx), Lerp(c01, c11, tx), ty)
+}
+
 // Performs a reverse [Lerp], returning the weight factor of the value in the range.
 //
 //   - Return is between [0, 1] if the value is between [from, to]
@@ -106,6 +335,29 @@ func InverseLerp[T Number](from, to, value T) T {
 	return (value - from) / (to - from)
 }
 
+// Maps a value from the range [inMin, inMax] to the range [outMin, outMax].
+//
+// Values outside the input range are extrapolated, resulting in values
+// outside the output range.
+// See [MapClamped] if this is not desired.
+//
+// The multiplication is done before the division, so integer types only
+// get truncated at the end.
+func Remap[T Number](value, inMin, inMax, outMin, outMax T) T {
+	return outMin + (value-inMin)*(outMax-outMin)/(inMax-inMin)
+}
+
+// Maps a value from the range [inMin, inMax] to the range [outMin, outMax],
+// where the value is first clamped to the input range.
+//
+// The result is therefore never outside of the output range,
+// making it suitable for things like UI bars and input curves.
+// See [Remap] for the unclamped version.
+func MapClamped[T Number](value, inMin, inMax, outMin, outMax T) T {
+	value = Clamp(value, min(inMin, inMax), max(inMin, inMax))
+	return Remap(value, inMin, inMax, outMin, outMax)
+}
+
 // Wraps float32 in the half-open range [min, max) by wrapping around instead of clamping.
 //
 // Using Wrap with min=0 is equivalent to using [Mod], so prefer using that
@@ -126,10 +378,142 @@ func Wrap[T Number](value, min, max T) T {
 	return result
 }
 
+// Wraps the value in the closed range [min, max], where "max" is reachable,
+// such as for a slider that should be able to rest exactly at its maximum.
+//
+// Values already in the range [min, max] are returned unchanged,
+// while [Wrap] would turn "max" into "min".
+//
+// For floats, values beyond the range wrap with a period of max-min.
+// Values landing exactly on a multiple of the period above "max" become "max",
+// and below "min" become "min". So for the range [0, 10], 20 becomes 10,
+// 12 becomes 2, and -10 becomes 0.
+//
+// For integers, the range contains max-min+1 distinct values, so the period
+// is max-min+1. So for the range [0, 10], 11 becomes 0 and -1 becomes 10.
+//
+// Returns "min" if max <= min.
+func WrapInclusive[T Number](value, min, max T) T {
+	if max <= min {
+		return min
+	}
+	if value >= min && value <= max {
+		return value
+	}
+	switch any(value).(type) {
+	case float32, float64:
+		result := min + Repeat(value-min, max-min)
+		if value > max && result == min {
+			return max
+		}
+		return result
+	default:
+		period := max - min + 1
+		if value < min {
+			// counted downwards from max, as value-min would underflow for unsigned integers
+			return max - Repeat(min-value-1, period)
+		}
+		return min + Repeat(value-min, period)
+	}
+}
+
+// Wraps the value in the half-open range [min, max), the same way as [Wrap],
+// but also returns how many times the value was wrapped.
+//
+// The number of times is positive if whole ranges were subtracted
+// (the value was above the range), and negative if whole ranges were added
+// (the value was below the range).
+// Useful for detecting when a full loop has been completed,
+// such as a spinner crossing zero.
+func WrapCount[T Number](value, min, max T) (wrapped T, times int) {
+	delta := max - min
+	if IsZeroApprox(delta) {
+		return min, 0
+	}
+	var n T
+	switch any(value).(type) {
+	case float32, float64:
+		n = Floor((value - min) / delta)
+	default:
+		if value < min && max > min {
+			// counted downwards from max, as value-min would underflow for unsigned integers
+			below := min - value
+			added := (below-1)/delta + 1
+			return max - Repeat(below-1, delta) - 1, -int(added)
+		}
+		// all other types are integers, where division truncates towards zero
+		n = (value - min) / delta
+		if n*delta > value-min {
+			n--
+		}
+	}
+	wrapped = value - delta*n
+	times = int(n)
+	if EqualApprox(wrapped, max) {
+		// rounding error, so treat it as having wrapped once more
+		return min, times + 1
+	}
+	return wrapped, times
+}
+
+// Wraps "t" in the half-open range [0, length), matching the semantics
+// of Unity's Mathf.Repeat.
+//
+// Negative values wrap around from the end, so Repeat(-1, 10) == 9.
+// The result is never equal to "length", not even due to floating point
+// rounding errors.
+//
+// This differs from [Wrap] with min=0, which uses approximate comparisons
+// and may snap values close to the edge.
+// It also differs from [Mod], which returns negative results for negative "t".
+//
+// Returns 0 if length <= 0.
+func Repeat[T Number](t, length T) T {
+	if length <= 0 {
+		return 0
+	}
+	var result T
+	switch any(t).(type) {
+	case float32, float64:
+		result = t - Floor(t/length)*length
+	default:
+		// all other types are integers, where division truncates towards zero
+		result = t - (t/length)*length
+		if result < 0 {
+			result += length
+		}
+	}
+	if result < 0 || result >= length {
+		// can only happen due to floating point rounding errors
+		return 0
+	}
+	return result
+}
+
+// Shortest signed distance to go from "from" to "to" on a ring of the
+// given length, such as a looping conveyor position or a day-night cycle.
+//
+// This generalizes [AngleDifference] to any cyclic quantity.
+// The result is in the half-open range [-length/2, length/2),
+// so when the values are exactly opposite then the negative distance is returned.
+// Use a signed type, as unsigned types cannot represent the negative distances.
+//
+// Returns 0 if length <= 0.
+func RingDelta[T Number](from, to, length T) T {
+	if length <= 0 {
+		return 0
+	}
+	diff := Repeat(to-from, length)
+	if diff*2 >= length {
+		diff -= length
+	}
+	return diff
+}
+
 // Check if two numbers are approximately equal to each other.
 //
 // The comparison done here is to see if the difference between the numbers
-// are less than [Epsilon].
+// are less than [DefaultEpsilon].
 //
 // Infinite values with the same sign (+/-) are considered equal.
 //
@@ -145,18 +529,19 @@ func EqualApprox[T Number](a, b T) bool {
 		if a == b {
 			return true
 		}
-		tolerance := Epsilon * tinymath.Abs(x)
-		if tolerance < Epsilon {
-			tolerance = Epsilon
+		tolerance := DefaultEpsilon * tinymath.Abs(x)
+		if tolerance < DefaultEpsilon {
+			tolerance = DefaultEpsilon
 		}
 		return tinymath.Abs(x-float32(b)) < tolerance
 	case float64:
 		if a == b {
 			return true
 		}
-		tolerance := Epsilon * math.Abs(x)
-		if tolerance < Epsilon {
-			tolerance = Epsilon
+		epsilon := float64(DefaultEpsilon)
+		tolerance := epsilon * math.Abs(x)
+		if tolerance < epsilon {
+			tolerance = epsilon
 		}
 		return math.Abs(x-float64(b)) < tolerance
 	default:
@@ -165,10 +550,23 @@ func EqualApprox[T Number](a, b T) bool {
 	}
 }
 
+// Check if a number is exactly zero, without any tolerance.
+//
+// For floats, both +0.0 and -0.0 are considered zero,
+// while tiny non-zero values such as 0.000001 are not.
+// Use [IsZeroApprox] to allow for rounding errors.
+//
+// This function is generic just as a utility so it can be used in conjunction
+// with other generic functions from this package.
+func IsZero[T Number](a T) bool {
+	// -0.0 == 0 is true for floats
+	return a == 0
+}
+
 // Check if a numbers is approximately equal to zero.
 //
 // The comparison done here is to see if the difference between the numbers
-// are less than [Epsilon].
+// are less than [DefaultEpsilon].
 //
 // This function is faster than running [EqualApprox](a, 0)
 //
@@ -181,9 +579,9 @@ func EqualApprox[T Number](a, b T) bool {
 func IsZeroApprox[T Number](a T) bool {
 	switch x := any(a).(type) {
 	case float32:
-		return tinymath.Abs(x) < Epsilon
+		return tinymath.Abs(x) < DefaultEpsilon
 	case float64:
-		return math.Abs(x) < Epsilon
+		return math.Abs(x) < float64(DefaultEpsilon)
 	default:
 		// all other types are integers
 		return a == 0
@@ -205,6 +603,14 @@ func Mod(lhs, rhs float32) float32 {
 	return float32(math.Mod(float64(lhs), float64(rhs)))
 }
 
+// Utility function to calculate the square root of a float32 using [math.Sqrt].
+//
+// Unlike [tinymath.Sqrt], this does not have a ~5% deviation, at the cost of
+// being more computationally intensive. Only use this where precision matters.
+func sqrtPrecise(x float32) float32 {
+	return float32(math.Sqrt(float64(x)))
+}
+
 // Generic function for getting the floored value of a number.
 //
 // Under the hood the function uses different code paths for different types:
@@ -271,6 +677,54 @@ func Round[T Number](a T) T {
 	}
 }
 
+// Snaps the value down to the nearest multiple of "step",
+// i.e towards negative infinity.
+//
+// Returns the value as-is if step <= 0.
+//
+// This function is generic just as a utility so it can be used in conjunction
+// with other generic functions from this package.
+func FloorToMultiple[T Number](value, step T) T {
+	if step <= 0 {
+		return value
+	}
+	switch any(value).(type) {
+	case float32, float64:
+		return Floor(value/step) * step
+	default:
+		// all other types are integers, where division truncates towards zero
+		q := value / step
+		if q*step > value {
+			q--
+		}
+		return q * step
+	}
+}
+
+// Snaps the value up to the nearest multiple of "step",
+// i.e towards positive infinity.
+//
+// Returns the value as-is if step <= 0.
+//
+// This function is generic just as a utility so it can be used in conjunction
+// with other generic functions from this package.
+func CeilToMultiple[T Number](value, step T) T {
+	if step <= 0 {
+		return value
+	}
+	switch any(value).(type) {
+	case float32, float64:
+		return Ceil(value/step) * step
+	default:
+		// all other types are integers, where division truncates towards zero
+		q := value / step
+		if q*step < value {
+			q++
+		}
+		return q * step
+	}
+}
+
 // Generic function for getting the absolute value of a number.
 //
 // Under the hood the function uses different code paths for different types:
@@ -304,14 +758,18 @@ func Abs[T Number](a T) T {
 //
 // Under the hood the function uses different code paths for different types:
 //
-//   - float32: [tinymath.Sign]
-//   - float64: [math.Copysign]
+//   - float32: 0 if a==0, [tinymath.Sign] otherwise
+//   - float64: 0 if a==0, [math.Copysign] otherwise
 //   - unsigned integers: 1 if a>0, 0 otherwise
 //   - signed integers: 1 if a>0, -1 if a<0, 0 otherwise
 //
 // This function is generic just as a utility so it can be used in conjunction
 // with other generic functions from this package.
 func Sign[T Number](a T) T {
+	if a == 0 {
+		// also covers -0.0, which the float functions would give -1 for
+		return 0
+	}
 	switch x := any(a).(type) {
 	case float32:
 		return T(tinymath.Sign(x))
@@ -319,19 +777,14 @@ func Sign[T Number](a T) T {
 		return T(math.Copysign(1.0, x))
 	case uint, uintptr, uint8, uint16, uint32, uint64:
 		// unsigned, can't be negative
-		if a == 0 {
-			return 0
-		}
 		return 1
 	default:
 		// all other types are signed integers
+		one := T(1)
 		if a < 0 {
-			return any(-1).(T)
-		}
-		if a > 0 {
-			return any(1).(T)
+			return -one
 		}
-		return a
+		return one
 	}
 }
 
@@ -339,3 +792,22 @@ func Sign[T Number](a T) T {
 func IsFinite(f float32) bool {
 	return !tinymath.IsNaN(f) && f > tinymath.NegInf && f < tinymath.Inf
 }
+
+// Cubic interpolation between "from" and "to" by the factor defined in "weight",
+// with "pre" and "post" as the values before and after the interpolated segment.
+//
+// This is the Catmull-Rom form of cubic interpolation, meaning the curve
+// passes through "from" at weight 0 and "to" at weight 1, where the
+// tangents are derived from the neighboring values.
+//
+// See also [CatmullRomSpline] for interpolating over a list of points.
+//
+// Based on the Godot [cubic_interpolate] (licensed under MIT)
+//
+// [cubic_interpolate]: https://github.com/godotengine/godot/blob/4.5.1-stable/core/math/math_funcs.h#L343-L356
+func CubicInterpolate[T Number](pre, from, to, post, weight T) T {
+	return (from*2 +
+		(-pre+to)*weight +
+		(pre*2-from*5+to*4-post)*(weight*weight) +
+		(-pre+from*3-to*3+post)*(weight*weight*weight)) / 2
+}
@@ -5,6 +5,7 @@
 package ffmath
 
 import (
+	"math"
 	"testing"
 
 	"github.com/orsinium-labs/tinymath"
@@ -36,3 +37,724 @@ func TestEqualApprox(t *testing.T) {
 		})
 	}
 }
+
+func TestSaturate(t *testing.T) {
+	t.Parallel()
+	values := []float32{-10, -1, -0.001, 0, 0.25, 0.5, 0.999, 1, 1.001, 10}
+
+	for _, value := range values {
+		want := Clamp01(value)
+		got := Saturate(value)
+		if got != want {
+			t.Errorf("Saturate(%v): want %v, got %v", value, want, got)
+		}
+		if gotVec := V(value, -value).Saturate(); gotVec != V(want, Clamp01(-value)) {
+			t.Errorf("V(%v, %v).Saturate(): want %v, got %v", value, -value, V(want, Clamp01(-value)), gotVec)
+		}
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	t.Parallel()
+	negZero := float32(math.Copysign(0, -1))
+	tests := []struct {
+		name       string
+		a          float32
+		want       bool
+		wantApprox bool
+	}{
+		{name: "zero", a: 0, want: true, wantApprox: true},
+		{name: "negative zero", a: negZero, want: true, wantApprox: true},
+		{name: "tiny positive", a: 0.000001, want: false, wantApprox: true},
+		{name: "tiny negative", a: -0.000001, want: false, wantApprox: true},
+		{name: "one", a: 1, want: false, wantApprox: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsZero(test.a); got != test.want {
+				t.Errorf("IsZero(%v): want %t, got %t", test.a, test.want, got)
+			}
+			if got := IsZeroApprox(test.a); got != test.wantApprox {
+				t.Errorf("IsZeroApprox(%v): want %t, got %t", test.a, test.wantApprox, got)
+			}
+			if got := V(test.a, test.a).IsZero(); got != test.want {
+				t.Errorf("V(%v, %v).IsZero(): want %t, got %t", test.a, test.a, test.want, got)
+			}
+		})
+	}
+}
+
+func TestFloorCeilToMultiple(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value, step         int
+		wantFloor, wantCeil int
+	}{
+		{value: 7, step: 4, wantFloor: 4, wantCeil: 8},
+		{value: 8, step: 4, wantFloor: 8, wantCeil: 8},
+		{value: 0, step: 4, wantFloor: 0, wantCeil: 0},
+		{value: -7, step: 4, wantFloor: -8, wantCeil: -4},
+		{value: -8, step: 4, wantFloor: -8, wantCeil: -8},
+		{value: 7, step: 0, wantFloor: 7, wantCeil: 7},
+		{value: 7, step: -4, wantFloor: 7, wantCeil: 7},
+	}
+
+	for _, test := range tests {
+		if got := FloorToMultiple(test.value, test.step); got != test.wantFloor {
+			t.Errorf("FloorToMultiple(%d, %d): want %d, got %d", test.value, test.step, test.wantFloor, got)
+		}
+		if got := CeilToMultiple(test.value, test.step); got != test.wantCeil {
+			t.Errorf("CeilToMultiple(%d, %d): want %d, got %d", test.value, test.step, test.wantCeil, got)
+		}
+
+		value, step := float32(test.value), float32(test.step)
+		if got := FloorToMultiple(value, step); got != float32(test.wantFloor) {
+			t.Errorf("FloorToMultiple(%v, %v): want %v, got %v", value, step, test.wantFloor, got)
+		}
+		if got := CeilToMultiple(value, step); got != float32(test.wantCeil) {
+			t.Errorf("CeilToMultiple(%v, %v): want %v, got %v", value, step, test.wantCeil, got)
+		}
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		t, length float32
+		want      float32
+	}{
+		{t: 0, length: 10, want: 0},
+		{t: 3, length: 10, want: 3},
+		{t: 10, length: 10, want: 0},
+		{t: 12.5, length: 10, want: 2.5},
+		{t: 35, length: 10, want: 5},
+		{t: -1, length: 10, want: 9},
+		{t: -10, length: 10, want: 0},
+		{t: -25, length: 10, want: 5},
+		{t: -0.0000001, length: 10, want: 0},
+		{t: 5, length: 0, want: 0},
+	}
+
+	for _, test := range tests {
+		got := Repeat(test.t, test.length)
+		if !EqualApprox(got, test.want) {
+			t.Errorf("Repeat(%v, %v): want %v, got %v", test.t, test.length, test.want, got)
+		}
+		if got >= test.length && test.length > 0 {
+			t.Errorf("Repeat(%v, %v): got %v, which is not below length", test.t, test.length, got)
+		}
+	}
+
+	intTests := []struct{ t, length, want int }{
+		{t: 10, length: 10, want: 0},
+		{t: 35, length: 10, want: 5},
+		{t: -1, length: 10, want: 9},
+		{t: -25, length: 10, want: 5},
+	}
+	for _, test := range intTests {
+		if got := Repeat(test.t, test.length); got != test.want {
+			t.Errorf("Repeat(%d, %d): want %d, got %d", test.t, test.length, test.want, got)
+		}
+	}
+}
+
+func TestRingDelta(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		from, to, length float32
+		want             float32
+	}{
+		{from: 2, to: 5, length: 10, want: 3},
+		{from: 5, to: 2, length: 10, want: -3},
+		// direct difference is longer than the wrapped one
+		{from: 9, to: 1, length: 10, want: 2},
+		{from: 1, to: 9, length: 10, want: -2},
+		{from: 0.5, to: 23.5, length: 24, want: -1},
+		// values outside the ring
+		{from: 25, to: -3, length: 10, want: 2},
+		// exactly opposite
+		{from: 0, to: 5, length: 10, want: -5},
+		{from: 3, to: 3, length: 10, want: 0},
+		{from: 3, to: 7, length: 0, want: 0},
+	}
+
+	for _, test := range tests {
+		got := RingDelta(test.from, test.to, test.length)
+		if !EqualApprox(got, test.want) {
+			t.Errorf("RingDelta(%v, %v, %v): want %v, got %v", test.from, test.to, test.length, test.want, got)
+		}
+	}
+
+	intTests := []struct{ from, to, length, want int }{
+		{from: 9, to: 1, length: 10, want: 2},
+		{from: 1, to: 9, length: 10, want: -2},
+		{from: 0, to: 2, length: 5, want: 2},
+		{from: 0, to: 3, length: 5, want: -2},
+		{from: -7, to: 7, length: 5, want: -1},
+	}
+
+	for _, test := range intTests {
+		got := RingDelta(test.from, test.to, test.length)
+		if got != test.want {
+			t.Errorf("RingDelta(%v, %v, %v): want %v, got %v", test.from, test.to, test.length, test.want, got)
+		}
+	}
+}
+
+func TestMoveTowards(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		start, end, delta float32
+		want              float32
+	}{
+		{name: "towards higher", start: 0, end: 10, delta: 3, want: 3},
+		{name: "towards lower", start: 0, end: -10, delta: 3, want: -3},
+		{name: "reaching end", start: 7, end: 10, delta: 3, want: 10},
+		{name: "delta larger than gap", start: 8, end: 10, delta: 5, want: 10},
+		{name: "delta larger than gap towards lower", start: -8, end: -10, delta: 5, want: -10},
+		{name: "already at end", start: 10, end: 10, delta: 3, want: 10},
+		{name: "zero delta", start: 0, end: 10, delta: 0, want: 0},
+		{name: "negative delta away from higher", start: 0, end: 10, delta: -3, want: -3},
+		{name: "negative delta away from lower", start: 0, end: -10, delta: -3, want: 3},
+		{name: "negative delta larger than gap", start: 8, end: 10, delta: -5, want: 3},
+		{name: "negative delta at end", start: 10, end: 10, delta: -3, want: 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := MoveTowards(test.start, test.end, test.delta)
+			if got != test.want {
+				t.Errorf("MoveTowards(%v, %v, %v): want %v, got %v", test.start, test.end, test.delta, test.want, got)
+			}
+			gotInt := MoveTowards(int8(test.start), int8(test.end), int8(test.delta))
+			if gotInt != int8(test.want) {
+				t.Errorf("MoveTowards[int8](%v, %v, %v): want %v, got %v", test.start, test.end, test.delta, test.want, gotInt)
+			}
+		})
+	}
+}
+
+func TestDecay(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name               string
+		value, rate, delta float32
+		want               float32
+	}{
+		{name: "positive", value: 10, rate: 4, delta: 0.5, want: 8},
+		{name: "negative", value: -10, rate: 4, delta: 0.5, want: -8},
+		{name: "stops at zero", value: 1, rate: 4, delta: 0.5, want: 0},
+		{name: "negative stops at zero", value: -1, rate: 4, delta: 0.5, want: 0},
+		{name: "exactly zero", value: 2, rate: 4, delta: 0.5, want: 0},
+		{name: "already zero", value: 0, rate: 4, delta: 0.5, want: 0},
+		{name: "no time passed", value: 10, rate: 4, delta: 0, want: 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Decay(test.value, test.rate, test.delta); got != test.want {
+				t.Errorf("Decay(%v, %v, %v): want %v, got %v", test.value, test.rate, test.delta, test.want, got)
+			}
+		})
+	}
+
+	// decaying repeatedly settles exactly at zero
+	value := 10
+	for range 10 {
+		value = Decay(value, 3, 1)
+	}
+	if value != 0 {
+		t.Errorf("Decay[int] repeated: want 0, got %v", value)
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	negZero := float32(math.Copysign(0, -1))
+	tests := []struct {
+		a    float32
+		want float32
+	}{
+		{a: 5, want: 1},
+		{a: -5, want: -1},
+		{a: 0, want: 0},
+		{a: negZero, want: 0},
+		{a: tinymath.Inf, want: 1},
+		{a: tinymath.NegInf, want: -1},
+	}
+
+	for _, test := range tests {
+		if got := Sign(test.a); got != test.want {
+			t.Errorf("Sign(%v): want %v, got %v", test.a, test.want, got)
+		}
+		if got := Sign(float64(test.a)); got != float64(test.want) {
+			t.Errorf("Sign[float64](%v): want %v, got %v", test.a, test.want, got)
+		}
+		if !IsFinite(test.a) {
+			continue
+		}
+		if got := Sign(int16(test.a)); got != int16(test.want) {
+			t.Errorf("Sign[int16](%v): want %v, got %v", test.a, test.want, got)
+		}
+	}
+}
+
+func TestWrapInclusive(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value, min, max float32
+		want            float32
+	}{
+		{value: 5, min: 0, max: 10, want: 5},
+		{value: 0, min: 0, max: 10, want: 0},
+		{value: 10, min: 0, max: 10, want: 10},
+		{value: 20, min: 0, max: 10, want: 10},
+		{value: 30, min: 0, max: 10, want: 10},
+		{value: 12, min: 0, max: 10, want: 2},
+		{value: -1, min: 0, max: 10, want: 9},
+		{value: -10, min: 0, max: 10, want: 0},
+		{value: 7.5, min: 2, max: 5, want: 4.5},
+		{value: 8, min: 2, max: 5, want: 5},
+		{value: 3, min: 5, max: 5, want: 5},
+	}
+
+	for _, test := range tests {
+		got := WrapInclusive(test.value, test.min, test.max)
+		if !EqualApprox(got, test.want) {
+			t.Errorf("WrapInclusive(%v, %v, %v): want %v, got %v", test.value, test.min, test.max, test.want, got)
+		}
+	}
+
+	intTests := []struct{ value, min, max, want int }{
+		{value: 10, min: 0, max: 10, want: 10},
+		{value: 11, min: 0, max: 10, want: 0},
+		{value: 21, min: 0, max: 10, want: 10},
+		{value: -1, min: 0, max: 10, want: 10},
+		{value: 7, min: 1, max: 3, want: 1},
+		{value: -11, min: 0, max: 10, want: 0},
+		{value: -3, min: 1, max: 3, want: 3},
+	}
+
+	for _, test := range intTests {
+		got := WrapInclusive(test.value, test.min, test.max)
+		if got != test.want {
+			t.Errorf("WrapInclusive(%v, %v, %v): want %v, got %v", test.value, test.min, test.max, test.want, got)
+		}
+	}
+
+	uintTests := []struct{ value, min, max, want uint }{
+		{value: 0, min: 2, max: 4, want: 3},
+		{value: 1, min: 2, max: 4, want: 4},
+		{value: 5, min: 2, max: 4, want: 2},
+		{value: 0, min: 1, max: 10, want: 10},
+	}
+
+	for _, test := range uintTests {
+		got := WrapInclusive(test.value, test.min, test.max)
+		if got != test.want {
+			t.Errorf("WrapInclusive[uint](%v, %v, %v): want %v, got %v", test.value, test.min, test.max, test.want, got)
+		}
+	}
+}
+
+func TestWrapCount(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value, min, max float32
+		want            float32
+		wantTimes       int
+	}{
+		{value: 5, min: 0, max: 10, want: 5, wantTimes: 0},
+		{value: 0, min: 0, max: 10, want: 0, wantTimes: 0},
+		{value: 10, min: 0, max: 10, want: 0, wantTimes: 1},
+		{value: 27, min: 0, max: 10, want: 7, wantTimes: 2},
+		{value: -3, min: 0, max: 10, want: 7, wantTimes: -1},
+		{value: -13, min: 0, max: 10, want: 7, wantTimes: -2},
+		{value: 36, min: 5, max: 10, want: 6, wantTimes: 6},
+		{value: -36, min: 5, max: 10, want: 9, wantTimes: -9},
+	}
+
+	for _, test := range tests {
+		got, gotTimes := WrapCount(test.value, test.min, test.max)
+		if !EqualApprox(got, test.want) || gotTimes != test.wantTimes {
+			t.Errorf("WrapCount(%v, %v, %v)\nwant: %v, %d\ngot:  %v, %d",
+				test.value, test.min, test.max, test.want, test.wantTimes, got, gotTimes)
+		}
+
+		gotInt, gotIntTimes := WrapCount(int(test.value), int(test.min), int(test.max))
+		if gotInt != int(test.want) || gotIntTimes != test.wantTimes {
+			t.Errorf("WrapCount[int](%v, %v, %v)\nwant: %v, %d\ngot:  %v, %d",
+				test.value, test.min, test.max, test.want, test.wantTimes, gotInt, gotIntTimes)
+		}
+	}
+
+	uintTests := []struct {
+		value, min, max uint
+		want            uint
+		wantTimes       int
+	}{
+		{value: 3, min: 5, max: 10, want: 8, wantTimes: -1},
+		{value: 0, min: 5, max: 10, want: 5, wantTimes: -1},
+		{value: 4, min: 5, max: 10, want: 9, wantTimes: -1},
+		{value: 1, min: 12, max: 16, want: 13, wantTimes: -3},
+		{value: 7, min: 5, max: 10, want: 7, wantTimes: 0},
+		{value: 23, min: 5, max: 10, want: 8, wantTimes: 3},
+	}
+
+	for _, test := range uintTests {
+		got, gotTimes := WrapCount(test.value, test.min, test.max)
+		if got != test.want || gotTimes != test.wantTimes {
+			t.Errorf("WrapCount[uint](%v, %v, %v)\nwant: %v, %d\ngot:  %v, %d",
+				test.value, test.min, test.max, test.want, test.wantTimes, got, gotTimes)
+		}
+	}
+}
+
+func TestMapClamped(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                string
+		value, inMin, inMax float32
+		outMin, outMax      float32
+		want, wantUnclamped float32
+	}{
+		{name: "below", value: -5, inMin: 0, inMax: 10, outMin: 100, outMax: 200, want: 100, wantUnclamped: 50},
+		{name: "at min", value: 0, inMin: 0, inMax: 10, outMin: 100, outMax: 200, want: 100, wantUnclamped: 100},
+		{name: "inside", value: 2.5, inMin: 0, inMax: 10, outMin: 100, outMax: 200, want: 125, wantUnclamped: 125},
+		{name: "at max", value: 10, inMin: 0, inMax: 10, outMin: 100, outMax: 200, want: 200, wantUnclamped: 200},
+		{name: "above", value: 15, inMin: 0, inMax: 10, outMin: 100, outMax: 200, want: 200, wantUnclamped: 250},
+		{name: "reversed input", value: 15, inMin: 10, inMax: 0, outMin: 0, outMax: 1, want: 0, wantUnclamped: -0.5},
+		{name: "reversed output", value: 15, inMin: 0, inMax: 10, outMin: 1, outMax: 0, want: 0, wantUnclamped: -0.5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := MapClamped(test.value, test.inMin, test.inMax, test.outMin, test.outMax)
+			if !EqualApprox(got, test.want) {
+				t.Errorf("MapClamped(%v, %v, %v, %v, %v): want %v, got %v",
+					test.value, test.inMin, test.inMax, test.outMin, test.outMax, test.want, got)
+			}
+			got = Remap(test.value, test.inMin, test.inMax, test.outMin, test.outMax)
+			if !EqualApprox(got, test.wantUnclamped) {
+				t.Errorf("Remap(%v, %v, %v, %v, %v): want %v, got %v",
+					test.value, test.inMin, test.inMax, test.outMin, test.outMax, test.wantUnclamped, got)
+			}
+		})
+	}
+}
+
+func TestRangeLen(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{name: "small", got: RangeLen(2, 5), want: 3},
+		{name: "negative", got: RangeLen(-5, -2), want: 3},
+		{name: "across zero", got: RangeLen(-5, 5), want: 10},
+		{name: "empty", got: RangeLen(5, 5), want: 0},
+		{name: "inverted", got: RangeLen(5, 2), want: 0},
+		{name: "full int8", got: RangeLen[int8](math.MinInt8, math.MaxInt8), want: 255},
+		{name: "full uint8", got: RangeLen[uint8](0, math.MaxUint8), want: 255},
+		{name: "full int32", got: RangeLen[int32](math.MinInt32, math.MaxInt32), want: min(math.MaxUint32, math.MaxInt)},
+		{name: "full int64", got: RangeLen[int64](math.MinInt64, math.MaxInt64), want: math.MaxInt},
+		{name: "full uint64", got: RangeLen[uint64](0, math.MaxUint64), want: math.MaxInt},
+	}
+
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("RangeLen %s: want %d, got %d", test.name, test.want, test.got)
+		}
+	}
+}
+
+func TestClampReport(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		val, min, max float32
+		want          float32
+		wantSide      int
+	}{
+		{val: 5, min: 0, max: 10, want: 5, wantSide: 0},
+		{val: -1, min: 0, max: 10, want: 0, wantSide: -1},
+		{val: 11, min: 0, max: 10, want: 10, wantSide: 1},
+		{val: 0, min: 0, max: 10, want: 0, wantSide: 0},
+		{val: 10, min: 0, max: 10, want: 10, wantSide: 0},
+		{val: -0.001, min: 0, max: 10, want: 0, wantSide: -1},
+		{val: 10.001, min: 0, max: 10, want: 10, wantSide: 1},
+	}
+
+	for _, test := range tests {
+		got, gotSide := ClampReport(test.val, test.min, test.max)
+		if got != test.want || gotSide != test.wantSide {
+			t.Errorf("ClampReport(%v, %v, %v): want %v, %d, got %v, %d",
+				test.val, test.min, test.max, test.want, test.wantSide, got, gotSide)
+		}
+	}
+
+	if got, side := ClampReport("m", "b", "k"); got != "k" || side != 1 {
+		t.Errorf(`ClampReport("m", "b", "k"): want "k", 1, got %q, %d`, got, side)
+	}
+}
+
+func TestMinMaxFloat(t *testing.T) {
+	t.Parallel()
+	nan := float32(math.NaN())
+	tests := []struct {
+		a, b    float32
+		wantMin float32
+		wantMax float32
+	}{
+		{a: 1, b: 2, wantMin: 1, wantMax: 2},
+		{a: 2, b: 1, wantMin: 1, wantMax: 2},
+		{a: -3, b: -3, wantMin: -3, wantMax: -3},
+		{a: nan, b: 2, wantMin: 2, wantMax: 2},
+		{a: 2, b: nan, wantMin: 2, wantMax: 2},
+		{a: float32(math.Inf(-1)), b: nan, wantMin: float32(math.Inf(-1)), wantMax: float32(math.Inf(-1))},
+	}
+
+	for _, test := range tests {
+		if got := MinFloat(test.a, test.b); got != test.wantMin {
+			t.Errorf("MinFloat(%v, %v): want %v, got %v", test.a, test.b, test.wantMin, got)
+		}
+		if got := MaxFloat(test.a, test.b); got != test.wantMax {
+			t.Errorf("MaxFloat(%v, %v): want %v, got %v", test.a, test.b, test.wantMax, got)
+		}
+	}
+
+	if got := MinFloat(nan, nan); !math.IsNaN(float64(got)) {
+		t.Errorf("MinFloat(NaN, NaN): want NaN, got %v", got)
+	}
+	if got := MaxFloat(math.NaN(), math.NaN()); !math.IsNaN(got) {
+		t.Errorf("MaxFloat(NaN, NaN): want NaN, got %v", got)
+	}
+}
+
+func TestInRange(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		val, min, max float32
+		want          bool
+		wantInclusive bool
+	}{
+		{val: 5, min: 0, max: 10, want: true, wantInclusive: true},
+		{val: 0, min: 0, max: 10, want: true, wantInclusive: true},
+		{val: 10, min: 0, max: 10, want: false, wantInclusive: true},
+		{val: -0.001, min: 0, max: 10, want: false, wantInclusive: false},
+		{val: 10.001, min: 0, max: 10, want: false, wantInclusive: false},
+		{val: 5, min: 5, max: 5, want: false, wantInclusive: true},
+	}
+
+	for _, test := range tests {
+		if got := InRange(test.val, test.min, test.max); got != test.want {
+			t.Errorf("InRange(%v, %v, %v): want %t, got %t", test.val, test.min, test.max, test.want, got)
+		}
+		if got := InRangeInclusive(test.val, test.min, test.max); got != test.wantInclusive {
+			t.Errorf("InRangeInclusive(%v, %v, %v): want %t, got %t", test.val, test.min, test.max, test.wantInclusive, got)
+		}
+	}
+}
+
+func TestClampToRange(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		v, min, max int
+		want        int
+	}{
+		{v: 3, min: 0, max: 10, want: 3},
+		{v: 0, min: 0, max: 10, want: 0},
+		{v: -1, min: 0, max: 10, want: 0},
+		{v: 10, min: 0, max: 10, want: 9},
+		{v: 15, min: 0, max: 10, want: 9},
+		{v: 5, min: 10, max: 10, want: 10},
+		{v: 5, min: 10, max: 0, want: 10},
+		{v: math.MaxInt, min: math.MinInt, max: math.MaxInt, want: math.MaxInt - 1},
+		{v: math.MinInt, min: math.MinInt, max: math.MaxInt, want: math.MinInt},
+	}
+
+	for _, test := range tests {
+		if got := ClampToRange(test.v, test.min, test.max); got != test.want {
+			t.Errorf("ClampToRange(%d, %d, %d): want %d, got %d", test.v, test.min, test.max, test.want, got)
+		}
+	}
+}
+
+func TestDefaultEpsilon(t *testing.T) {
+	// not parallel, as it changes the global DefaultEpsilon
+	t.Cleanup(func() { DefaultEpsilon = Epsilon })
+
+	if EqualApprox[float32](1, 1.05) {
+		t.Errorf("EqualApprox(1, 1.05) with DefaultEpsilon=%v: want false, got true", DefaultEpsilon)
+	}
+	if IsZeroApprox[float64](0.05) {
+		t.Errorf("IsZeroApprox(0.05) with DefaultEpsilon=%v: want false, got true", DefaultEpsilon)
+	}
+
+	DefaultEpsilon = 0.1
+
+	if !EqualApprox[float32](1, 1.05) {
+		t.Errorf("EqualApprox(1, 1.05) with DefaultEpsilon=%v: want true, got false", DefaultEpsilon)
+	}
+	if !EqualApprox[float64](1, 1.05) {
+		t.Errorf("EqualApprox[float64](1, 1.05) with DefaultEpsilon=%v: want true, got false", DefaultEpsilon)
+	}
+	if !IsZeroApprox[float64](0.05) {
+		t.Errorf("IsZeroApprox(0.05) with DefaultEpsilon=%v: want true, got false", DefaultEpsilon)
+	}
+	if EqualApprox[float32](1, 1.5) {
+		t.Errorf("EqualApprox(1, 1.5) with DefaultEpsilon=%v: want false, got true", DefaultEpsilon)
+	}
+}
+
+func TestMoveTowardsDone(t *testing.T) {
+	t.Parallel()
+	got, done := MoveTowardsDone[float32](8, 10, 5)
+	if got != 10 || !done {
+		t.Errorf("MoveTowardsDone(8, 10, 5)\nwant: 10, true\ngot:  %v, %t", got, done)
+	}
+
+	got, done = MoveTowardsDone[float32](10, 10, 5)
+	if got != 10 || !done {
+		t.Errorf("MoveTowardsDone(10, 10, 5)\nwant: 10, true\ngot:  %v, %t", got, done)
+	}
+
+	value := float32(0)
+	var steps int
+	for done = false; !done; steps++ {
+		value, done = MoveTowardsDone(value, 10, 3)
+	}
+	if value != 10 || steps != 4 {
+		t.Errorf("MoveTowardsDone(0, 10, 3) repeated\nwant: 10 after 4 steps\ngot:  %v after %d steps", value, steps)
+	}
+}
+
+func TestBilerp(t *testing.T) {
+	t.Parallel()
+	const c00, c10, c01, c11 float32 = 1, 3, 5, 11
+	tests := []struct {
+		tx, ty float32
+		want   float32
+	}{
+		{tx: 0, ty: 0, want: c00},
+		{tx: 1, ty: 0, want: c10},
+		{tx: 0, ty: 1, want: c01},
+		{tx: 1, ty: 1, want: c11},
+		{tx: 0.25, ty: 0, want: Lerp(c00, c10, 0.25)},
+		{tx: 0.25, ty: 1, want: Lerp(c01, c11, 0.25)},
+		{tx: 0, ty: 0.75, want: Lerp(c00, c01, 0.75)},
+		{tx: 0.5, ty: 0.5, want: (c00 + c10 + c01 + c11) / 4},
+	}
+
+	for _, test := range tests {
+		got := Bilerp(c00, c10, c01, c11, test.tx, test.ty)
+		if !EqualApprox(got, test.want) {
+			t.Errorf("Bilerp(%v, %v, %v, %v, %v, %v): want %v, got %v",
+				c00, c10, c01, c11, test.tx, test.ty, test.want, got)
+		}
+		gotVec := BilerpVec(V(c00, -c00), V(c10, -c10), V(c01, -c01), V(c11, -c11), test.tx, test.ty)
+		if !gotVec.EqualApprox(V(test.want, -test.want)) {
+			t.Errorf("BilerpVec(..., %v, %v): want %v, got %v", test.tx, test.ty, V(test.want, -test.want), gotVec)
+		}
+	}
+}
+
+func TestDampFactor(t *testing.T) {
+	t.Parallel()
+	if got := DampFactor(0.1, 1.0/60); !EqualApprox(got, 0.1) {
+		t.Errorf("DampFactor(0.1, 1/60): want 0.1, got %v", got)
+	}
+	if got := DampFactor(0.1, 0); got != 0 {
+		t.Errorf("DampFactor(0.1, 0): want 0, got %v", got)
+	}
+	if got := DampFactor(1, 1.0/60); got != 1 {
+		t.Errorf("DampFactor(1, 1/60): want 1, got %v", got)
+	}
+	if got := DampFactor(0, 1.0/60); got != 0 {
+		t.Errorf("DampFactor(0, 1/60): want 0, got %v", got)
+	}
+
+	// Smoothing over 1 second should end up at the same value,
+	// regardless of how the second is split into frames.
+	const target = 100
+	var want float32
+	for i, frames := range []int{60, 30, 144, 7, 1} {
+		var value float32
+		delta := float32(1) / float32(frames)
+		for range frames {
+			value = Lerp(value, target, DampFactor(0.05, delta))
+		}
+		if i == 0 {
+			want = value
+			continue
+		}
+		if Abs(value-want) > 0.01 {
+			t.Errorf("DampFactor(0.05, 1/%d) over 1 second: want %v, got %v", frames, want, value)
+		}
+	}
+}
+
+func TestISqrt(t *testing.T) {
+	t.Parallel()
+	tests := []struct{ n, want int64 }{
+		{n: 0, want: 0},
+		{n: 1, want: 1},
+		{n: 2, want: 1},
+		{n: 3, want: 1},
+		{n: 4, want: 2},
+		{n: 15, want: 3},
+		{n: 16, want: 4},
+		{n: 17, want: 4},
+		{n: 99, want: 9},
+		{n: 100, want: 10},
+		{n: 101, want: 10},
+		{n: -1, want: 0},
+		{n: math.MinInt64, want: 0},
+		// too large to be represented exactly by float64
+		{n: 3037000499*3037000499 - 1, want: 3037000498},
+		{n: 3037000499 * 3037000499, want: 3037000499},
+		{n: math.MaxInt64, want: 3037000499},
+	}
+
+	for _, test := range tests {
+		if got := ISqrt(test.n); got != test.want {
+			t.Errorf("ISqrt(%v): want %v, got %v", test.n, test.want, got)
+		}
+	}
+
+	if got := ISqrt(uint64(math.MaxUint64)); got != math.MaxUint32 {
+		t.Errorf("ISqrt(uint64(%v)): want %v, got %v", uint64(math.MaxUint64), math.MaxUint32, got)
+	}
+	if got := ISqrt(uint8(255)); got != 15 {
+		t.Errorf("ISqrt(uint8(255)): want 15, got %v", got)
+	}
+}
+
+func TestLerpInt(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		from, to int
+		weight   float32
+		want     int
+	}{
+		{from: 0, to: 100, weight: 0, want: 0},
+		{from: 0, to: 100, weight: 1, want: 100},
+		{from: 0, to: 100, weight: 0.5, want: 50},
+		{from: 0, to: 5, weight: 0.5, want: 3},
+		{from: 0, to: -5, weight: 0.5, want: -3},
+		{from: 10, to: 0, weight: 0.33, want: 7},
+		{from: 0, to: 3, weight: 0.1, want: 0},
+		{from: 0, to: 3, weight: 0.2, want: 1},
+		{from: 0, to: 100, weight: 1.5, want: 100},
+		{from: 0, to: 100, weight: -0.5, want: 0},
+		{from: 0, to: 1 << 30, weight: 1, want: 1 << 30},
+	}
+
+	for _, test := range tests {
+		if got := LerpInt(test.from, test.to, test.weight); got != test.want {
+			t.Errorf("LerpInt(%v, %v, %v): want %v, got %v", test.from, test.to, test.weight, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Checks which side of the line going through "a" and "b" that "point" is on.
+//
+// The result is the sign of the cross product of (b-a) and (point-a):
+//
+//   - +1 if the point is on the right side of the line
+//   - -1 if the point is on the left side of the line
+//   - 0 if the point is exactly on the line
+//
+// Left and right are as seen on the screen (where Y points down)
+// when looking from "a" towards "b".
+// In a coordinate system where Y points up the sides would be flipped.
+func SideOfLine(point, a, b Vec) int {
+	cross := b.Sub(a).Cross(point.Sub(a))
+	switch {
+	case cross > 0:
+		return 1
+	case cross < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Calculates the barycentric coordinates of "p" in the triangle "a", "b", "c".
+//
+// The returned weights are such that:
+//
+//	p == a.Scale(u).Add(b.Scale(v)).Add(c.Scale(w))
+//	u + v + w == 1
+//
+// Useful for interpolating values such as colors over a triangle.
+// All weights are in the range [0, 1] when "p" is inside the triangle,
+// and at least one weight is negative when "p" is outside of it.
+//
+// If the triangle is degenerate (has zero area) then the weights
+// can't be calculated and all weights are returned as zero.
+func Barycentric(p, a, b, c Vec) (u, v, w float32) {
+	ab := b.Sub(a)
+	ac := c.Sub(a)
+	denom := ab.Cross(ac)
+	if denom == 0 {
+		return 0, 0, 0
+	}
+	ap := p.Sub(a)
+	v = ap.Cross(ac) / denom
+	w = ab.Cross(ap) / denom
+	u = 1 - v - w
+	return u, v, w
+}
+
+// Calculates the orientation of the triangle "a", "b", "c",
+// as the cross product of (b-a) and (c-a).
+//
+// The result is the signed doubled area of the triangle:
+//
+//   - positive if the points go clockwise on the screen (where Y points down),
+//     which is counter-clockwise in a coordinate system where Y points up
+//   - negative if the points go counter-clockwise on the screen
+//   - 0 if the points are on a line
+//
+// This is the basic building block for convex hulls, triangulation, and
+// point-in-triangle checks. See also [SideOfLine] and [TriangleArea].
+func Orient2D(a, b, c Vec) float32 {
+	return b.Sub(a).Cross(c.Sub(a))
+}
+
+// Calculates the unsigned area of the triangle "a", "b", "c",
+// as half the absolute value of [Orient2D].
+//
+// Returns 0 for degenerate triangles, where all points are on a line.
+func TriangleArea(a, b, c Vec) float32 {
+	return Abs(Orient2D(a, b, c)) / 2
+}
+
+// Calculates the unsigned area of the quad "a", "b", "c", "d",
+// where the points are in order around the quad (either winding).
+//
+// The quad must be convex. The area is half the absolute cross product
+// of its two diagonals, which is cheaper than splitting it into two triangles.
+func QuadArea(a, b, c, d Vec) float32 {
+	return Abs(c.Sub(a).Cross(d.Sub(b))) / 2
+}
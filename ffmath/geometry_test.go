@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestSideOfLine(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		point Vec
+		a, b  Vec
+		want  int
+	}{
+		{name: "below horizontal line", point: V(5, 10), a: V(0, 0), b: V(10, 0), want: 1},
+		{name: "above horizontal line", point: V(5, -10), a: V(0, 0), b: V(10, 0), want: -1},
+		{name: "above reversed line", point: V(5, -10), a: V(10, 0), b: V(0, 0), want: 1},
+		{name: "on line", point: V(5, 5), a: V(0, 0), b: V(10, 10), want: 0},
+		{name: "on line extension", point: V(-5, -5), a: V(0, 0), b: V(10, 10), want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := SideOfLine(test.point, test.a, test.b)
+			if got != test.want {
+				t.Errorf("SideOfLine(%v, %v, %v): want %d, got %d", test.point, test.a, test.b, test.want, got)
+			}
+		})
+	}
+}
+
+func TestBarycentric(t *testing.T) {
+	t.Parallel()
+	a, b, c := V(0, 0), V(9, 0), V(0, 9)
+	tests := []struct {
+		name         string
+		p            Vec
+		a, b, c      Vec
+		wantU, wantV float32
+		wantW        float32
+	}{
+		{name: "vertex a", p: a, a: a, b: b, c: c, wantU: 1, wantV: 0, wantW: 0},
+		{name: "vertex b", p: b, a: a, b: b, c: c, wantU: 0, wantV: 1, wantW: 0},
+		{name: "vertex c", p: c, a: a, b: b, c: c, wantU: 0, wantV: 0, wantW: 1},
+		{name: "centroid", p: V(3, 3), a: a, b: b, c: c, wantU: 1. / 3, wantV: 1. / 3, wantW: 1. / 3},
+		{name: "outside", p: V(-3, 3), a: a, b: b, c: c, wantU: 1, wantV: -1. / 3, wantW: 1. / 3},
+		{name: "degenerate", p: V(1, 1), a: a, b: V(1, 1), c: V(2, 2), wantU: 0, wantV: 0, wantW: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u, v, w := Barycentric(test.p, test.a, test.b, test.c)
+			if !EqualApprox(u, test.wantU) || !EqualApprox(v, test.wantV) || !EqualApprox(w, test.wantW) {
+				t.Errorf("Barycentric(%v, %v, %v, %v)\nwant: %v, %v, %v\ngot:  %v, %v, %v",
+					test.p, test.a, test.b, test.c, test.wantU, test.wantV, test.wantW, u, v, w)
+			}
+		})
+	}
+}
+
+func TestOrient2D(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		a, b, c Vec
+		want    float32
+	}{
+		// right, then down, which is clockwise on the screen
+		{name: "clockwise on screen", a: V(0, 0), b: V(1, 0), c: V(0, 1), want: 1},
+		{name: "counter-clockwise on screen", a: V(0, 0), b: V(0, 1), c: V(1, 0), want: -1},
+		{name: "offset", a: V(10, 10), b: V(14, 10), c: V(12, 13), want: 12},
+		{name: "rotated order keeps sign", a: V(14, 10), b: V(12, 13), c: V(10, 10), want: 12},
+		{name: "collinear", a: V(0, 0), b: V(1, 1), c: V(2, 2), want: 0},
+		{name: "collinear outside segment", a: V(0, 0), b: V(1, 1), c: V(-3, -3), want: 0},
+		{name: "same points", a: V(5, 5), b: V(5, 5), c: V(5, 5), want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Orient2D(test.a, test.b, test.c)
+			if !EqualApprox(got, test.want) {
+				t.Errorf("Orient2D(%v, %v, %v): want %v, got %v", test.a, test.b, test.c, test.want, got)
+			}
+			if sign := SideOfLine(test.c, test.a, test.b); sign != int(Sign(got)) {
+				t.Errorf("SideOfLine(%v, %v, %v) = %d, want same sign as Orient2D %v", test.c, test.a, test.b, sign, got)
+			}
+		})
+	}
+}
+
+func TestTriangleArea(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		a, b, c Vec
+		want    float32
+	}{
+		{name: "unit", a: V(0, 0), b: V(1, 0), c: V(0, 1), want: 0.5},
+		{name: "reversed winding", a: V(0, 0), b: V(0, 1), c: V(1, 0), want: 0.5},
+		{name: "offset", a: V(10, 10), b: V(14, 10), c: V(12, 13), want: 6},
+		{name: "degenerate", a: V(0, 0), b: V(1, 1), c: V(2, 2), want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := TriangleArea(test.a, test.b, test.c); !EqualApprox(got, test.want) {
+				t.Errorf("TriangleArea(%v, %v, %v): want %v, got %v", test.a, test.b, test.c, test.want, got)
+			}
+		})
+	}
+}
+
+func TestQuadArea(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		a, b, c, d Vec
+		want       float32
+	}{
+		{name: "unit square", a: V(0, 0), b: V(1, 0), c: V(1, 1), d: V(0, 1), want: 1},
+		{name: "reversed winding", a: V(0, 0), b: V(0, 1), c: V(1, 1), d: V(1, 0), want: 1},
+		{name: "rectangle", a: V(2, 3), b: V(6, 3), c: V(6, 5), d: V(2, 5), want: 8},
+		{name: "diamond", a: V(0, -1), b: V(2, 0), c: V(0, 1), d: V(-2, 0), want: 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := QuadArea(test.a, test.b, test.c, test.d); !EqualApprox(got, test.want) {
+				t.Errorf("QuadArea(%v, %v, %v, %v): want %v, got %v", test.a, test.b, test.c, test.d, test.want, got)
+			}
+		})
+	}
+}
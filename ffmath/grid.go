@@ -0,0 +1,325 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"slices"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+)
+
+// Get the 4 orthogonal neighbors of a grid cell.
+//
+// The neighbors are ordered clockwise on the screen (where Y points down),
+// starting to the right, in the same direction as [Vec.Azimuth]:
+//
+//  1. right (X+1)
+//  2. down (Y+1)
+//  3. left (X-1)
+//  4. up (Y-1)
+func Neighbors4(p firefly.Point) [4]firefly.Point {
+	return [4]firefly.Point{
+		{X: p.X + 1, Y: p.Y},
+		{X: p.X, Y: p.Y + 1},
+		{X: p.X - 1, Y: p.Y},
+		{X: p.X, Y: p.Y - 1},
+	}
+}
+
+// Get all 8 surrounding neighbors of a grid cell, including diagonals.
+//
+// The neighbors are ordered clockwise on the screen (where Y points down),
+// starting to the right, in the same direction as [Vec.Azimuth]:
+//
+//  1. right (X+1)
+//  2. down-right (X+1, Y+1)
+//  3. down (Y+1)
+//  4. down-left (X-1, Y+1)
+//  5. left (X-1)
+//  6. up-left (X-1, Y-1)
+//  7. up (Y-1)
+//  8. up-right (X+1, Y-1)
+func Neighbors8(p firefly.Point) [8]firefly.Point {
+	return [8]firefly.Point{
+		{X: p.X + 1, Y: p.Y},
+		{X: p.X + 1, Y: p.Y + 1},
+		{X: p.X, Y: p.Y + 1},
+		{X: p.X - 1, Y: p.Y + 1},
+		{X: p.X - 1, Y: p.Y},
+		{X: p.X - 1, Y: p.Y - 1},
+		{X: p.X, Y: p.Y - 1},
+		{X: p.X + 1, Y: p.Y - 1},
+	}
+}
+
+// Get all grid cells along the line from "a" to "b", both inclusive,
+// using Bresenham's line algorithm.
+//
+// See [BresenhamLineFunc] for a version that doesn't allocate a slice.
+func BresenhamLine(a, b firefly.Point) []firefly.Point {
+	length := max(Abs(b.X-a.X), Abs(b.Y-a.Y)) + 1
+	points := make([]firefly.Point, 0, length)
+	BresenhamLineFunc(a, b, func(p firefly.Point) bool {
+		points = append(points, p)
+		return true
+	})
+	return points
+}
+
+// Visits all grid cells along the line from "a" to "b", both inclusive,
+// using Bresenham's line algorithm.
+//
+// The cells are visited in order from "a" to "b".
+// Stops early if "visit" returns false, which is useful for line of sight
+// checks that should stop at the first obstacle.
+func BresenhamLineFunc(a, b firefly.Point, visit func(firefly.Point) bool) {
+	dx := Abs(b.X - a.X)
+	dy := -Abs(b.Y - a.Y)
+	stepX := Sign(b.X - a.X)
+	stepY := Sign(b.Y - a.Y)
+	err := dx + dy
+	p := a
+	for {
+		if !visit(p) || p == b {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			p.X += stepX
+		}
+		if e2 <= dx {
+			err += dx
+			p.Y += stepY
+		}
+	}
+}
+
+// Get all grid cells within a circle, such as for explosion radiuses
+// or revealing fog of war.
+//
+// A cell is included if its center lies within the radius, inclusive,
+// i.e if dx*dx + dy*dy <= radius*radius where dx and dy are the offsets
+// from the circle's center cell.
+// A radius of 0 only includes the center cell,
+// and a negative radius includes no cells.
+//
+// See [CircleCellsFunc] for a version that doesn't allocate a slice.
+func CircleCells(center firefly.Point, radius int) []firefly.Point {
+	var points []firefly.Point
+	CircleCellsFunc(center, radius, func(p firefly.Point) bool {
+		points = append(points, p)
+		return true
+	})
+	return points
+}
+
+// Visits all grid cells within a circle, using the same inclusion rule
+// as [CircleCells].
+//
+// The cells are visited row by row, from the top-left to the bottom-right.
+// Stops early if "visit" returns false.
+func CircleCellsFunc(center firefly.Point, radius int, visit func(firefly.Point) bool) {
+	radiusSquared := radius * radius
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			if dx*dx+dy*dy > radiusSquared {
+				continue
+			}
+			if !visit(firefly.Point{X: center.X + dx, Y: center.Y + dy}) {
+				return
+			}
+		}
+	}
+}
+
+// Visits all grid cells in the half-open rectangular region from "min"
+// (inclusive) to "max" (exclusive), such as for spawning a room of tiles
+// or clearing an area.
+//
+// The cells are visited row by row (row-major), from the top-left to the bottom-right.
+// Stops early if "visit" returns false.
+// Nothing is visited if the region is empty, i.e if max.X <= min.X or max.Y <= min.Y.
+func RectCells(min, max firefly.Point, visit func(firefly.Point) bool) {
+	for y := min.Y; y < max.Y; y++ {
+		for x := min.X; x < max.X; x++ {
+			if !visit(firefly.Point{X: x, Y: y}) {
+				return
+			}
+		}
+	}
+}
+
+// Performs a 4-connected flood fill from "start", such as for bucket fill
+// tools or for detecting connected regions.
+//
+// Each reachable cell where "canFill" returns true is visited exactly once.
+// Nothing is visited if "canFill" returns false for the start cell.
+//
+// Uses an explicit stack instead of recursion to not overflow
+// the limited stack on the Firefly Zero.
+func FloodFill(start firefly.Point, canFill func(firefly.Point) bool, visit func(firefly.Point)) {
+	if !canFill(start) {
+		return
+	}
+	seen := map[firefly.Point]struct{}{start: {}}
+	stack := []firefly.Point{start}
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		visit(p)
+		for _, n := range Neighbors4(p) {
+			if _, ok := seen[n]; ok {
+				continue
+			}
+			seen[n] = struct{}{}
+			if canFill(n) {
+				stack = append(stack, n)
+			}
+		}
+	}
+}
+
+// Computes the number of 4-connected steps from each reachable cell to "goal",
+// also known as a distance field or Dijkstra map.
+//
+// For flow-field pathfinding where many agents head towards the same goal,
+// this is computed once, and each agent then descends the distances
+// by using [FlowDirection], instead of running [AStar] per agent.
+//
+// Only passable cells inside "bounds" are included, where a cell is inside
+// if its position is in the half-open range [bounds.Min, bounds.Max).
+// The goal itself has distance 0 and is included even if not passable,
+// as long as it is inside the bounds.
+// Unreachable cells are not in the returned map.
+//
+// Uses a breadth-first search, as all steps have the same cost.
+func DistanceField(goal firefly.Point, passable func(firefly.Point) bool, bounds Rect) map[firefly.Point]int {
+	field := map[firefly.Point]int{}
+	if !VPoint(goal).InRange(bounds.Min, bounds.Max) {
+		return field
+	}
+	field[goal] = 0
+	queue := []firefly.Point{goal}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		dist := field[p] + 1
+		for _, n := range Neighbors4(p) {
+			if _, ok := field[n]; ok {
+				continue
+			}
+			if !VPoint(n).InRange(bounds.Min, bounds.Max) || !passable(n) {
+				continue
+			}
+			field[n] = dist
+			queue = append(queue, n)
+		}
+	}
+	return field
+}
+
+// Get the downhill direction in a distance field from [DistanceField],
+// i.e the step to take from "at" to get closer to the goal.
+//
+// The returned direction is the offset to the neighboring cell with the
+// lowest distance, such as {1, 0} for moving right,
+// so the next cell is at.Add(direction).
+// When multiple neighbors are equally close, the first one in the order
+// of [Neighbors4] is picked.
+//
+// Returns false if "at" is the goal, or if it is not in the field
+// (i.e unreachable or outside the bounds).
+func FlowDirection(field map[firefly.Point]int, at firefly.Point) (firefly.Point, bool) {
+	dist, ok := field[at]
+	if !ok || dist == 0 {
+		return firefly.Point{}, false
+	}
+	var best firefly.Point
+	found := false
+	for _, n := range Neighbors4(at) {
+		nDist, ok := field[n]
+		if !ok || nDist >= dist {
+			continue
+		}
+		dist = nDist
+		best = n
+		found = true
+	}
+	if !found {
+		return firefly.Point{}, false
+	}
+	return best.Sub(at), true
+}
+
+// Get the Manhattan distance between two grid cells,
+// i.e the number of steps when only moving orthogonally.
+//
+// Can be used as the heuristic in [AStar].
+func ManhattanDistance(a, b firefly.Point) float32 {
+	return float32(Abs(b.X-a.X) + Abs(b.Y-a.Y))
+}
+
+// Finds the shortest path from "start" to "goal" using the A* algorithm,
+// moving orthogonally between cells where "passable" returns true.
+// Each step has a cost of 1.
+//
+// The "heuristic" estimates the remaining cost between two cells,
+// and should never overestimate it for the path to be the shortest.
+// Passing nil uses [ManhattanDistance].
+//
+// Returns the path including both "start" and "goal",
+// or false if there is no path.
+//
+// The "passable" function must return false outside of the grid bounds,
+// as otherwise an unreachable goal makes the search go on forever.
+func AStar(start, goal firefly.Point, passable func(firefly.Point) bool, heuristic func(a, b firefly.Point) float32) ([]firefly.Point, bool) {
+	if heuristic == nil {
+		heuristic = ManhattanDistance
+	}
+	cameFrom := map[firefly.Point]firefly.Point{}
+	costs := map[firefly.Point]float32{start: 0}
+	var open PriorityQueue[astarItem]
+	open.Push(astarItem{point: start}, heuristic(start, goal))
+	for open.Len() > 0 {
+		current, _ := open.Pop()
+		if current.point == goal {
+			return astarPath(cameFrom, start, goal), true
+		}
+		cost := costs[current.point]
+		if current.cost > cost {
+			// outdated entry, a cheaper path to this cell has already been found
+			continue
+		}
+		for _, n := range Neighbors4(current.point) {
+			newCost := cost + 1
+			if oldCost, ok := costs[n]; ok && oldCost <= newCost {
+				continue
+			}
+			if !passable(n) {
+				continue
+			}
+			costs[n] = newCost
+			cameFrom[n] = current.point
+			open.Push(astarItem{point: n, cost: newCost}, newCost+heuristic(n, goal))
+		}
+	}
+	return nil, false
+}
+
+func astarPath(cameFrom map[firefly.Point]firefly.Point, start, goal firefly.Point) []firefly.Point {
+	path := []firefly.Point{goal}
+	for p := goal; p != start; {
+		p = cameFrom[p]
+		path = append(path, p)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+type astarItem struct {
+	point firefly.Point
+	cost  float32
+}
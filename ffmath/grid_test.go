@@ -0,0 +1,411 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+)
+
+func TestNeighbors4(t *testing.T) {
+	t.Parallel()
+	got := Neighbors4(firefly.P(5, -3))
+	want := [4]firefly.Point{
+		firefly.P(6, -3),
+		firefly.P(5, -2),
+		firefly.P(4, -3),
+		firefly.P(5, -4),
+	}
+	if got != want {
+		t.Errorf("Neighbors4(%v)\nwant: %v\ngot:  %v", firefly.P(5, -3), want, got)
+	}
+}
+
+func TestNeighbors8(t *testing.T) {
+	t.Parallel()
+	got := Neighbors8(firefly.P(5, -3))
+	want := [8]firefly.Point{
+		firefly.P(6, -3),
+		firefly.P(6, -2),
+		firefly.P(5, -2),
+		firefly.P(4, -2),
+		firefly.P(4, -3),
+		firefly.P(4, -4),
+		firefly.P(5, -4),
+		firefly.P(6, -4),
+	}
+	if got != want {
+		t.Errorf("Neighbors8(%v)\nwant: %v\ngot:  %v", firefly.P(5, -3), want, got)
+	}
+}
+
+func TestBresenhamLine(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		a, b firefly.Point
+		want []firefly.Point
+	}{
+		{
+			name: "single point",
+			a:    firefly.P(2, 2), b: firefly.P(2, 2),
+			want: []firefly.Point{firefly.P(2, 2)},
+		},
+		{
+			name: "horizontal",
+			a:    firefly.P(0, 1), b: firefly.P(3, 1),
+			want: []firefly.Point{firefly.P(0, 1), firefly.P(1, 1), firefly.P(2, 1), firefly.P(3, 1)},
+		},
+		{
+			name: "horizontal reversed",
+			a:    firefly.P(3, 1), b: firefly.P(0, 1),
+			want: []firefly.Point{firefly.P(3, 1), firefly.P(2, 1), firefly.P(1, 1), firefly.P(0, 1)},
+		},
+		{
+			name: "vertical",
+			a:    firefly.P(1, 0), b: firefly.P(1, -3),
+			want: []firefly.Point{firefly.P(1, 0), firefly.P(1, -1), firefly.P(1, -2), firefly.P(1, -3)},
+		},
+		{
+			name: "45 degrees",
+			a:    firefly.P(0, 0), b: firefly.P(-3, -3),
+			want: []firefly.Point{firefly.P(0, 0), firefly.P(-1, -1), firefly.P(-2, -2), firefly.P(-3, -3)},
+		},
+		{
+			name: "steep",
+			a:    firefly.P(0, 0), b: firefly.P(2, 5),
+			want: []firefly.Point{firefly.P(0, 0), firefly.P(0, 1), firefly.P(1, 2), firefly.P(1, 3), firefly.P(2, 4), firefly.P(2, 5)},
+		},
+		{
+			name: "shallow",
+			a:    firefly.P(3, 1), b: firefly.P(-2, 3),
+			want: []firefly.Point{firefly.P(3, 1), firefly.P(2, 1), firefly.P(1, 2), firefly.P(0, 2), firefly.P(-1, 3), firefly.P(-2, 3)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := BresenhamLine(test.a, test.b)
+			if !slices.Equal(got, test.want) {
+				t.Errorf("BresenhamLine(%v, %v)\nwant: %v\ngot:  %v", test.a, test.b, test.want, got)
+			}
+		})
+	}
+}
+
+func TestBresenhamLineFuncStopsEarly(t *testing.T) {
+	t.Parallel()
+	var got []firefly.Point
+	BresenhamLineFunc(firefly.P(0, 0), firefly.P(10, 0), func(p firefly.Point) bool {
+		got = append(got, p)
+		return p.X < 2
+	})
+	want := []firefly.Point{firefly.P(0, 0), firefly.P(1, 0), firefly.P(2, 0)}
+	if !slices.Equal(got, want) {
+		t.Errorf("BresenhamLineFunc stopping at X=2\nwant: %v\ngot:  %v", want, got)
+	}
+}
+
+func TestCircleCells(t *testing.T) {
+	t.Parallel()
+	center := firefly.P(10, -5)
+	tests := []struct {
+		radius   int
+		wantLen  int
+		included []firefly.Point
+		excluded []firefly.Point
+	}{
+		{radius: -1, wantLen: 0, excluded: []firefly.Point{center}},
+		{radius: 0, wantLen: 1, included: []firefly.Point{center}},
+		{
+			radius:   1,
+			wantLen:  5,
+			included: []firefly.Point{center, firefly.P(11, -5), firefly.P(10, -6)},
+			excluded: []firefly.Point{firefly.P(11, -4), firefly.P(9, -6)},
+		},
+		{
+			radius:   2,
+			wantLen:  13,
+			included: []firefly.Point{center, firefly.P(12, -5), firefly.P(11, -4), firefly.P(9, -6)},
+			excluded: []firefly.Point{firefly.P(12, -4), firefly.P(8, -7)},
+		},
+	}
+
+	for _, test := range tests {
+		got := CircleCells(center, test.radius)
+		if len(got) != test.wantLen {
+			t.Errorf("CircleCells(%v, %d): want %d cells, got %d: %v", center, test.radius, test.wantLen, len(got), got)
+		}
+		for _, p := range test.included {
+			if !slices.Contains(got, p) {
+				t.Errorf("CircleCells(%v, %d): want %v included, got %v", center, test.radius, p, got)
+			}
+		}
+		for _, p := range test.excluded {
+			if slices.Contains(got, p) {
+				t.Errorf("CircleCells(%v, %d): want %v excluded, got %v", center, test.radius, p, got)
+			}
+		}
+	}
+}
+
+// Parses a grid where '#' is a wall and any other character is open.
+func parseTestGrid(rows ...string) func(firefly.Point) bool {
+	return func(p firefly.Point) bool {
+		if p.Y < 0 || p.Y >= len(rows) || p.X < 0 || p.X >= len(rows[p.Y]) {
+			return false
+		}
+		return rows[p.Y][p.X] != '#'
+	}
+}
+
+func TestFloodFill(t *testing.T) {
+	t.Parallel()
+	canFill := parseTestGrid(
+		"#######",
+		"#...#..",
+		"#...#..",
+		"#####..",
+	)
+
+	visited := map[firefly.Point]int{}
+	FloodFill(firefly.P(1, 1), canFill, func(p firefly.Point) {
+		visited[p]++
+	})
+
+	if len(visited) != 6 {
+		t.Errorf("FloodFill: want 6 cells visited, got %d: %v", len(visited), visited)
+	}
+	for p, count := range visited {
+		if count != 1 {
+			t.Errorf("FloodFill: want %v visited once, got %d times", p, count)
+		}
+		if p.X < 1 || p.X > 3 || p.Y < 1 || p.Y > 2 {
+			t.Errorf("FloodFill: leaked through wall to %v", p)
+		}
+	}
+
+	visited = map[firefly.Point]int{}
+	FloodFill(firefly.P(0, 0), canFill, func(p firefly.Point) {
+		visited[p]++
+	})
+	if len(visited) != 0 {
+		t.Errorf("FloodFill from wall: want 0 cells visited, got %d: %v", len(visited), visited)
+	}
+}
+
+func TestAStar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		grid      []string
+		start     firefly.Point
+		goal      firefly.Point
+		wantLen   int
+		wantFound bool
+	}{
+		{
+			name: "open grid",
+			grid: []string{
+				".....",
+				".....",
+				".....",
+			},
+			start: firefly.P(0, 0), goal: firefly.P(4, 2),
+			wantLen: 7, wantFound: true,
+		},
+		{
+			name: "start is goal",
+			grid: []string{
+				"...",
+			},
+			start: firefly.P(1, 0), goal: firefly.P(1, 0),
+			wantLen: 1, wantFound: true,
+		},
+		{
+			name: "wall requiring detour",
+			grid: []string{
+				"..#..",
+				"..#..",
+				"..#..",
+				".....",
+			},
+			start: firefly.P(0, 0), goal: firefly.P(4, 0),
+			wantLen: 11, wantFound: true,
+		},
+		{
+			name: "unreachable",
+			grid: []string{
+				"..#..",
+				"..#..",
+				"..#..",
+			},
+			start: firefly.P(0, 0), goal: firefly.P(4, 0),
+			wantFound: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			passable := parseTestGrid(test.grid...)
+			path, found := AStar(test.start, test.goal, passable, nil)
+			if found != test.wantFound {
+				t.Fatalf("AStar(%v, %v): want found=%t, got %t", test.start, test.goal, test.wantFound, found)
+			}
+			if !found {
+				return
+			}
+			if len(path) != test.wantLen {
+				t.Errorf("AStar(%v, %v): want path length %d, got %d: %v", test.start, test.goal, test.wantLen, len(path), path)
+			}
+			if path[0] != test.start || path[len(path)-1] != test.goal {
+				t.Errorf("AStar(%v, %v): path does not go from start to goal: %v", test.start, test.goal, path)
+			}
+			for i, p := range path {
+				if !passable(p) {
+					t.Errorf("AStar(%v, %v): path goes through wall at %v", test.start, test.goal, p)
+				}
+				if i > 0 && ManhattanDistance(path[i-1], p) != 1 {
+					t.Errorf("AStar(%v, %v): path jumps from %v to %v", test.start, test.goal, path[i-1], p)
+				}
+			}
+		})
+	}
+}
+
+func TestRectCells(t *testing.T) {
+	t.Parallel()
+	var got []firefly.Point
+	RectCells(firefly.P(1, -1), firefly.P(4, 1), func(p firefly.Point) bool {
+		got = append(got, p)
+		return true
+	})
+	want := []firefly.Point{
+		firefly.P(1, -1), firefly.P(2, -1), firefly.P(3, -1),
+		firefly.P(1, 0), firefly.P(2, 0), firefly.P(3, 0),
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("RectCells:\nwant: %v\ngot:  %v", want, got)
+	}
+
+	got = nil
+	RectCells(firefly.P(0, 0), firefly.P(10, 10), func(p firefly.Point) bool {
+		got = append(got, p)
+		return len(got) < 12
+	})
+	if len(got) != 12 || got[11] != firefly.P(1, 1) {
+		t.Errorf("RectCells with early stop: want 12 cells ending at %v, got %d: %v", firefly.P(1, 1), len(got), got)
+	}
+
+	for _, max := range []firefly.Point{firefly.P(0, 5), firefly.P(5, 0), firefly.P(-1, -1)} {
+		RectCells(firefly.P(0, 0), max, func(p firefly.Point) bool {
+			t.Errorf("RectCells(%v, %v): want no cells, got %v", firefly.P(0, 0), max, p)
+			return true
+		})
+	}
+}
+
+func TestDistanceFieldOpen(t *testing.T) {
+	t.Parallel()
+	open := func(firefly.Point) bool { return true }
+	goal := firefly.P(2, 2)
+	field := DistanceField(goal, open, Rect{Min: V(0, 0), Max: V(5, 5)})
+
+	if len(field) != 25 {
+		t.Errorf("want 25 cells, got %d", len(field))
+	}
+	// on an open grid the distances form diamond-shaped rings around the goal
+	for p, dist := range field {
+		want := Abs(p.X-goal.X) + Abs(p.Y-goal.Y)
+		if dist != want {
+			t.Errorf("distance at %v: want %d, got %d", p, want, dist)
+		}
+	}
+	if _, ok := field[firefly.P(5, 2)]; ok {
+		t.Errorf("want %v outside of bounds to be excluded", firefly.P(5, 2))
+	}
+
+	if outside := DistanceField(firefly.P(9, 9), open, Rect{Min: V(0, 0), Max: V(5, 5)}); len(outside) != 0 {
+		t.Errorf("goal outside of bounds: want empty field, got %v", outside)
+	}
+}
+
+func TestDistanceFieldObstacle(t *testing.T) {
+	t.Parallel()
+	passable := parseTestGrid(
+		".....",
+		".###.",
+		"...#.",
+		"##.#.",
+		"#..#.",
+	)
+	field := DistanceField(firefly.P(1, 2), passable, Rect{Min: V(0, 0), Max: V(5, 5)})
+
+	tests := []struct {
+		p    firefly.Point
+		want int
+	}{
+		{p: firefly.P(1, 2), want: 0},
+		{p: firefly.P(0, 2), want: 1},
+		{p: firefly.P(2, 4), want: 3},
+		{p: firefly.P(1, 4), want: 4},
+		// has to route around the wall, via the top row
+		{p: firefly.P(4, 2), want: 9},
+		{p: firefly.P(4, 4), want: 11},
+	}
+
+	for _, test := range tests {
+		got, ok := field[test.p]
+		if !ok || got != test.want {
+			t.Errorf("distance at %v: want %d, got %d (found: %t)", test.p, test.want, got, ok)
+		}
+	}
+	if _, ok := field[firefly.P(1, 1)]; ok {
+		t.Errorf("want wall %v to be excluded", firefly.P(1, 1))
+	}
+}
+
+func TestFlowDirection(t *testing.T) {
+	t.Parallel()
+	passable := parseTestGrid(
+		".....",
+		".###.",
+		"...#.",
+		"##.#.",
+		"#..#.",
+	)
+	goal := firefly.P(1, 2)
+	field := DistanceField(goal, passable, Rect{Min: V(0, 0), Max: V(5, 5)})
+
+	if _, ok := FlowDirection(field, goal); ok {
+		t.Errorf("FlowDirection at goal: want false")
+	}
+	if _, ok := FlowDirection(field, firefly.P(1, 1)); ok {
+		t.Errorf("FlowDirection at wall: want false")
+	}
+	if dir, ok := FlowDirection(field, firefly.P(0, 2)); !ok || dir != firefly.P(1, 0) {
+		t.Errorf("FlowDirection at %v: want %v, true, got %v, %t", firefly.P(0, 2), firefly.P(1, 0), dir, ok)
+	}
+
+	// following the directions from any cell should reach the goal
+	for start, dist := range field {
+		at := start
+		for range dist {
+			dir, ok := FlowDirection(field, at)
+			if !ok {
+				t.Fatalf("FlowDirection from %v: got stuck at %v", start, at)
+			}
+			if ManhattanDistance(firefly.Point{}, dir) != 1 {
+				t.Fatalf("FlowDirection from %v: want a single step, got %v at %v", start, dir, at)
+			}
+			at = at.Add(dir)
+		}
+		if at != goal {
+			t.Errorf("FlowDirection from %v: want to reach %v in %d steps, ended at %v", start, goal, dist, at)
+		}
+	}
+}
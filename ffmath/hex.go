@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "github.com/orsinium-labs/tinymath"
+
+// Functions for hex grids, using axial coordinates (q, r) for
+// pointy-top hexagons, where the hexagons have a corner pointing up and down,
+// and rows are shifted half a hex to the right for each step down in "r".
+//
+// The "size" is the distance from a hex's center to its corners,
+// so each hex is sqrt(3)*size wide and 2*size tall.
+// The hex at (0, 0) is centered on the origin.
+//
+// Based on the [Hexagonal Grids] guide by Red Blob Games.
+//
+// [Hexagonal Grids]: https://www.redblobgames.com/grids/hexagons/
+
+const sqrt3 = 1.7320508075688772
+
+// Converts a position to the axial coordinates of the pointy-top hex it is in.
+//
+// See [HexToVec] for the layout.
+func VecToHex(v Vec, size float32) (q, r int) {
+	fq := (sqrt3/3*v.X - v.Y/3) / size
+	fr := (2. / 3 * v.Y) / size
+	return hexRound(fq, fr)
+}
+
+// Converts axial hex coordinates to the position of the pointy-top hex's center.
+//
+// The "q" axis points right, and the "r" axis points down-right
+// (as seen on the screen, where Y points down).
+func HexToVec(q, r int, size float32) Vec {
+	return V(
+		size*(sqrt3*float32(q)+sqrt3/2*float32(r)),
+		size*(1.5*float32(r)),
+	)
+}
+
+// Rounds fractional axial coordinates to the nearest hex,
+// by rounding in cube coordinates (q + r + s = 0).
+func hexRound(fq, fr float32) (q, r int) {
+	fs := -fq - fr
+	rq := tinymath.Round(fq)
+	rr := tinymath.Round(fr)
+	rs := tinymath.Round(fs)
+	dq := tinymath.Abs(rq - fq)
+	dr := tinymath.Abs(rr - fr)
+	ds := tinymath.Abs(rs - fs)
+	// reset the component with the largest rounding error,
+	// to keep the q + r + s = 0 constraint
+	if dq > dr && dq > ds {
+		rq = -rr - rs
+	} else if dr > ds {
+		rr = -rq - rs
+	}
+	return int(rq), int(rr)
+}
+
+// Get the 6 neighbors of a hex, in axial coordinates {q, r}.
+//
+// The neighbors are ordered clockwise on the screen (where Y points down),
+// starting with the one to the right: right, down-right, down-left,
+// left, up-left, and up-right.
+func HexNeighbors(q, r int) [6][2]int {
+	return [6][2]int{
+		{q + 1, r},
+		{q, r + 1},
+		{q - 1, r + 1},
+		{q - 1, r},
+		{q, r - 1},
+		{q + 1, r - 1},
+	}
+}
+
+// Get the distance between two hexes in axial coordinates,
+// i.e the number of steps between neighboring hexes.
+func HexDistance(q1, r1, q2, r2 int) int {
+	dq := q1 - q2
+	dr := r1 - r2
+	// the third cube coordinate is s = -q - r
+	ds := -dq - dr
+	return (Abs(dq) + Abs(dr) + Abs(ds)) / 2
+}
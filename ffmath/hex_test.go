@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestHexToVec(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		q, r int
+		want Vec
+	}{
+		{q: 0, r: 0, want: V(0, 0)},
+		{q: 1, r: 0, want: V(17.320508, 0)},
+		{q: 0, r: 1, want: V(8.660254, 15)},
+		{q: -1, r: 1, want: V(-8.660254, 15)},
+		{q: 0, r: -2, want: V(-17.320508, -30)},
+	}
+
+	for _, test := range tests {
+		if got := HexToVec(test.q, test.r, 10); !got.EqualApprox(test.want) {
+			t.Errorf("HexToVec(%d, %d, 10): want %v, got %v", test.q, test.r, test.want, got)
+		}
+	}
+}
+
+func TestVecToHexRoundTrip(t *testing.T) {
+	t.Parallel()
+	coords := [][2]int{{0, 0}, {1, 0}, {0, 1}, {-1, 1}, {3, -2}, {-4, -5}, {7, 7}}
+	// offsets well within the hex, which has an inner radius of sqrt(3)/2*size
+	offsets := []Vec{V(0, 0), V(4, 0), V(-4, 0), V(0, 6), V(0, -6), V(3, 3), V(-3, -3)}
+
+	for _, c := range coords {
+		center := HexToVec(c[0], c[1], 10)
+		for _, offset := range offsets {
+			q, r := VecToHex(center.Add(offset), 10)
+			if q != c[0] || r != c[1] {
+				t.Errorf("VecToHex(%v + %v, 10): want %d, %d, got %d, %d", center, offset, c[0], c[1], q, r)
+			}
+		}
+	}
+}
+
+func TestVecToHexBoundary(t *testing.T) {
+	t.Parallel()
+	// just across the shared edge between (0, 0) and (1, 0), which is at x = sqrt(3)/2*size
+	if q, r := VecToHex(V(8.5, 0), 10); q != 0 || r != 0 {
+		t.Errorf("VecToHex(V(8.5, 0), 10): want 0, 0, got %d, %d", q, r)
+	}
+	if q, r := VecToHex(V(8.8, 0), 10); q != 1 || r != 0 {
+		t.Errorf("VecToHex(V(8.8, 0), 10): want 1, 0, got %d, %d", q, r)
+	}
+	// just across the top corner of (0, 0), which is at y = -size
+	if q, r := VecToHex(V(0, -9.5), 10); q != 0 || r != 0 {
+		t.Errorf("VecToHex(V(0, -9.5), 10): want 0, 0, got %d, %d", q, r)
+	}
+	if q, r := VecToHex(V(0, -10.5), 10); q == 0 && r == 0 {
+		t.Errorf("VecToHex(V(0, -10.5), 10): want outside of 0, 0")
+	}
+}
+
+func TestHexNeighbors(t *testing.T) {
+	t.Parallel()
+	center := HexToVec(2, -3, 10)
+	prev := V(0, 0)
+	for i, n := range HexNeighbors(2, -3) {
+		if d := HexDistance(2, -3, n[0], n[1]); d != 1 {
+			t.Errorf("neighbor %d %v: want distance 1, got %d", i, n, d)
+		}
+		// neighbor centers are sqrt(3)*size away
+		offset := HexToVec(n[0], n[1], 10).Sub(center)
+		if dist := sqrtPrecise(offset.RadiusSquared()); !EqualApprox(dist, 17.320508) {
+			t.Errorf("neighbor %d %v: want center 17.32 away, got %v", i, n, dist)
+		}
+		// clockwise on screen means each offset is on the right side of the previous
+		if i > 0 && prev.Cross(offset) <= 0 {
+			t.Errorf("neighbor %d %v: want clockwise order after %v, got %v", i, n, prev, offset)
+		}
+		prev = offset
+	}
+	if first := HexNeighbors(0, 0)[0]; first != [2]int{1, 0} {
+		t.Errorf("first neighbor: want right {1, 0}, got %v", first)
+	}
+}
+
+func TestHexDistance(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		q1, r1, q2, r2 int
+		want           int
+	}{
+		{q1: 0, r1: 0, q2: 0, r2: 0, want: 0},
+		{q1: 3, r1: -1, q2: 3, r2: -1, want: 0},
+		{q1: 0, r1: 0, q2: 1, r2: 0, want: 1},
+		{q1: 0, r1: 0, q2: -1, r2: 1, want: 1},
+		{q1: 0, r1: 0, q2: 3, r2: -1, want: 3},
+		{q1: 0, r1: 0, q2: 2, r2: 2, want: 4},
+		{q1: -2, r1: 5, q2: 4, r2: -3, want: 8},
+	}
+
+	for _, test := range tests {
+		if got := HexDistance(test.q1, test.r1, test.q2, test.r2); got != test.want {
+			t.Errorf("HexDistance(%d, %d, %d, %d): want %d, got %d", test.q1, test.r1, test.q2, test.r2, test.want, got)
+		}
+		if got := HexDistance(test.q2, test.r2, test.q1, test.r1); got != test.want {
+			t.Errorf("HexDistance(%d, %d, %d, %d): want %d, got %d", test.q2, test.r2, test.q1, test.r1, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "slices"
+
+// Counts samples in evenly sized buckets, such as for checking the
+// distribution of loot tables and spawn weights while tuning them.
+type Histogram struct {
+	min, max float32
+	counts   []int
+	total    int
+}
+
+// Creates a new [Histogram] that splits the range [min, max] into
+// "buckets" evenly sized buckets.
+//
+// It panics if buckets <= 0 or if max <= min.
+func NewHistogram(min, max float32, buckets int) Histogram {
+	if buckets <= 0 || !(max > min) {
+		panic("invalid argument to NewHistogram")
+	}
+	return Histogram{min: min, max: max, counts: make([]int, buckets)}
+}
+
+// Adds a sample to the bucket that contains it.
+//
+// Each bucket includes its lower edge, and the last bucket also includes
+// "max". Samples outside the range [min, max] are counted in the first or
+// last bucket, whichever is closest, so that no samples are lost.
+// NaN samples are ignored.
+func (h *Histogram) Add(x float32) {
+	if x != x {
+		return
+	}
+	buckets := len(h.counts)
+	i := int(Clamp((x-h.min)/(h.max-h.min)*float32(buckets), 0, float32(buckets-1)))
+	h.counts[i]++
+	h.total++
+}
+
+// Get the number of samples in each bucket, in order from "min" to "max".
+//
+// The returned slice is a copy, so it is safe to modify.
+func (h *Histogram) Counts() []int {
+	return slices.Clone(h.counts)
+}
+
+// Get the fraction of all samples in each bucket, in order from "min" to "max",
+// where all fractions sum up to 1.
+//
+// Returns all zeros if no samples have been added.
+func (h *Histogram) Normalized() []float32 {
+	result := make([]float32, len(h.counts))
+	if h.total == 0 {
+		return result
+	}
+	for i, count := range h.counts {
+		result[i] = float32(count) / float32(h.total)
+	}
+	return result
+}
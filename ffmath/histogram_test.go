@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	t.Parallel()
+	h := NewHistogram(0, 10, 5)
+	if got, want := h.Normalized(), []float32{0, 0, 0, 0, 0}; !slices.Equal(got, want) {
+		t.Errorf("empty Normalized(): want %v, got %v", want, got)
+	}
+
+	// 0.5, 1.5, ..., 9.5 spreads evenly with two samples per bucket,
+	// then the edges and out of range samples go to the ends.
+	for i := range 10 {
+		h.Add(float32(i) + 0.5)
+	}
+	for _, x := range []float32{0, -3, 2, 10, 25, float32(math.NaN())} {
+		h.Add(x)
+	}
+
+	if got, want := h.Counts(), []int{4, 3, 2, 2, 4}; !slices.Equal(got, want) {
+		t.Errorf("Counts(): want %v, got %v", want, got)
+	}
+	// 15 samples in total, where the NaN is ignored
+	if got, want := h.Normalized(), []float32{4.0 / 15, 3.0 / 15, 2.0 / 15, 2.0 / 15, 4.0 / 15}; !slices.EqualFunc(got, want, EqualApprox) {
+		t.Errorf("Normalized(): want %v, got %v", want, got)
+	}
+
+	h.Counts()[0] = 100
+	if got := h.Counts()[0]; got != 4 {
+		t.Errorf("Counts() was modified through the returned slice: want 4, got %d", got)
+	}
+}
+
+func TestNewHistogramPanics(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		min, max float32
+		buckets  int
+	}{
+		{name: "zero buckets", min: 0, max: 1, buckets: 0},
+		{name: "empty range", min: 1, max: 1, buckets: 4},
+		{name: "reversed range", min: 1, max: 0, buckets: 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewHistogram(%v, %v, %d): want panic", test.min, test.max, test.buckets)
+				}
+			}()
+			NewHistogram(test.min, test.max, test.buckets)
+		})
+	}
+}
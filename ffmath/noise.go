@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "github.com/firefly-zero/firefly-go/firefly"
+
+// Hashes a 2D integer coordinate together with a seed into a
+// pseudo-random value in the range [0, 1].
+//
+// The same input always gives the same output, on every device and every
+// run, and neighboring coordinates give unrelated values.
+// This is white noise, so it is not smooth like Perlin noise.
+func HashNoise(x, y, seed int32) float32 {
+	h := uint32(x)*0x27d4eb2d ^ uint32(y)*0x165667b1 ^ uint32(seed)*0x9e3779b9
+	// Finalizer from MurmurHash3, to spread every input bit to every output bit.
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	// Use the top 24 bits, which fit exactly in a float32 mantissa.
+	return float32(h>>8) / (1<<24 - 1)
+}
+
+// Get the threshold at which a pixel is revealed in a dissolve transition.
+//
+// Draw the pixel once the transition's progress, going from 0 to 1,
+// has reached the threshold:
+//
+//	if ffmath.DissolveThreshold(p, seed) <= progress {
+//		firefly.DrawPoint(p, color)
+//	}
+//
+// The result is in the range [0, 1], and is stateless and deterministic
+// based on [HashNoise], so the transition looks the same every time it is
+// played with the same seed. Use a different seed for a different pattern.
+func DissolveThreshold(p firefly.Point, seed int32) float32 {
+	return HashNoise(int32(p.X), int32(p.Y), seed)
+}
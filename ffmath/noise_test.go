@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"testing"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+)
+
+func TestHashNoise(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		x, y, seed int32
+	}{
+		{x: 0, y: 0, seed: 0},
+		{x: 1, y: 0, seed: 0},
+		{x: 0, y: 1, seed: 0},
+		{x: -5, y: 7, seed: 42},
+		{x: 2147483647, y: -2147483648, seed: -1},
+	}
+
+	for _, test := range tests {
+		got := HashNoise(test.x, test.y, test.seed)
+		if got < 0 || got > 1 {
+			t.Errorf("HashNoise(%d, %d, %d) = %f, want in range [0, 1]", test.x, test.y, test.seed, got)
+		}
+		if again := HashNoise(test.x, test.y, test.seed); again != got {
+			t.Errorf("HashNoise(%d, %d, %d) not deterministic\nfirst:  %f\nsecond: %f", test.x, test.y, test.seed, got, again)
+		}
+	}
+	if HashNoise(1, 2, 0) == HashNoise(2, 1, 0) {
+		t.Errorf("HashNoise(1, 2, 0) == HashNoise(2, 1, 0), want swapped coordinates to differ")
+	}
+}
+
+func TestDissolveThreshold(t *testing.T) {
+	t.Parallel()
+	const buckets = 10
+	var counts [buckets]int
+	total := 0
+	for y := range firefly.Height {
+		for x := range firefly.Width {
+			p := firefly.P(x, y)
+			got := DissolveThreshold(p, 7)
+			if got < 0 || got > 1 {
+				t.Fatalf("DissolveThreshold(%v, 7) = %f, want in range [0, 1]", p, got)
+			}
+			if again := DissolveThreshold(p, 7); again != got {
+				t.Fatalf("DissolveThreshold(%v, 7) not deterministic\nfirst:  %f\nsecond: %f", p, got, again)
+			}
+			counts[min(int(got*buckets), buckets-1)]++
+			total++
+		}
+	}
+
+	// Every tenth of the range should get roughly a tenth of the pixels.
+	want := total / buckets
+	for i, count := range counts {
+		if count < want*9/10 || count > want*11/10 {
+			t.Errorf("DissolveThreshold bucket %d/%d got %d pixels, want ~%d", i, buckets, count, want)
+		}
+	}
+
+	same := 0
+	for x := range 100 {
+		p := firefly.P(x, 0)
+		if DissolveThreshold(p, 1) == DissolveThreshold(p, 2) {
+			same++
+		}
+	}
+	if same > 1 {
+		t.Errorf("DissolveThreshold with different seeds gave the same value for %d/100 pixels", same)
+	}
+}
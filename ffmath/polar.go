@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "github.com/firefly-zero/firefly-go/firefly"
+
+// Polar coordinate, defined by an angle and a distance from the origin.
+//
+// The angle uses the same direction as [Vec.Azimuth],
+// where [firefly.Degrees](90) points towards positive Y.
+type Polar struct {
+	Angle  firefly.Angle
+	Radius float32
+}
+
+// Converts a [Vec] to a [Polar] coordinate.
+//
+// Uses [tinymath] for faster but less accurate calculation.
+// See [Vec.Azimuth] and [Vec.Radius] for their errors.
+func VecToPolar(v Vec) Polar {
+	return Polar{Angle: v.Azimuth(), Radius: v.Radius()}
+}
+
+// Converts the polar coordinate to a [Vec].
+//
+// Uses [tinymath] for faster but less accurate calculation, with a max error of 0.002.
+func (p Polar) ToVec() Vec {
+	return V(p.Radius, 0).Rotate(p.Angle)
+}
+
+// Linearly interpolates between two polar coordinates by the factor defined in "weight".
+//
+// The angle and radius are interpolated separately,
+// where the angle takes the shortest arc (see [LerpAngle]).
+// This results in curved paths, such as spirals when the radius changes.
+func (p Polar) Lerp(to Polar, weight float32) Polar {
+	return Polar{
+		Angle:  LerpAngle(p.Angle, to.Angle, weight),
+		Radius: Lerp(p.Radius, to.Radius, weight),
+	}
+}
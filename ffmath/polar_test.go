@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"testing"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+	"github.com/orsinium-labs/tinymath"
+)
+
+func TestPolarRoundTrip(t *testing.T) {
+	t.Parallel()
+	tests := []Vec{V(4, 0), V(0, 4), V(-16, 0), V(0, -1), V(3, 4), V(-5, 12), V(7, -24)}
+
+	for _, v := range tests {
+		polar := VecToPolar(v)
+		got := polar.ToVec()
+		// tinymath.Sqrt has an average deviation of ~5%
+		if !got.EqualApproxRel(v, 0.05) {
+			t.Errorf("VecToPolar(%v).ToVec()\nwant: %v\ngot:  %v (polar: %v°, %v)",
+				v, v, got, polar.Angle.Degrees(), polar.Radius)
+		}
+	}
+}
+
+func TestPolarLerp(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		from, to   Polar
+		weight     float32
+		wantDeg    float32
+		wantRadius float32
+	}{
+		{
+			name:    "halfway",
+			from:    Polar{Angle: firefly.Degrees(0), Radius: 10},
+			to:      Polar{Angle: firefly.Degrees(90), Radius: 20},
+			weight:  0.5,
+			wantDeg: 45, wantRadius: 15,
+		},
+		{
+			name:    "across seam forward",
+			from:    Polar{Angle: firefly.Degrees(350), Radius: 10},
+			to:      Polar{Angle: firefly.Degrees(30), Radius: 30},
+			weight:  0.25,
+			wantDeg: 360, wantRadius: 15,
+		},
+		{
+			name:    "across seam backward",
+			from:    Polar{Angle: firefly.Degrees(10), Radius: 8},
+			to:      Polar{Angle: firefly.Degrees(330), Radius: 4},
+			weight:  0.5,
+			wantDeg: -10, wantRadius: 6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.from.Lerp(test.to, test.weight)
+			gotDeg := tinymath.Round(got.Angle.Degrees())
+			if gotDeg != test.wantDeg || !EqualApprox(got.Radius, test.wantRadius) {
+				t.Errorf("%v.Lerp(%v, %v)\nwant: %v°, %v\ngot:  %v°, %v",
+					test.from, test.to, test.weight, test.wantDeg, test.wantRadius, gotDeg, got.Radius)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Min-heap priority queue, where the item with the lowest priority is
+// popped first, such as for pathfinding or scheduling events.
+//
+// Items with equal priorities are popped in the order they were pushed.
+//
+// The zero value is an empty queue ready to use.
+// Popped items reuse the underlying memory, so a queue that is reused
+// (e.g once per frame) only allocates when it grows beyond its previous size.
+type PriorityQueue[T any] struct {
+	items []queueItem[T]
+	seq   uint64
+}
+
+type queueItem[T any] struct {
+	item     T
+	priority float32
+	// insertion order, used to keep equal priorities stable
+	seq uint64
+}
+
+// Number of items in the queue.
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.items)
+}
+
+// Adds an item to the queue with the given priority.
+func (q *PriorityQueue[T]) Push(item T, priority float32) {
+	q.items = append(q.items, queueItem[T]{item: item, priority: priority, seq: q.seq})
+	q.seq++
+	q.up(len(q.items) - 1)
+}
+
+// Removes and returns the item with the lowest priority.
+//
+// Returns false if the queue is empty.
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	top := q.items[0]
+	last := len(q.items) - 1
+	q.items[0] = q.items[last]
+	// clear to not keep any pointers in T alive
+	q.items[last] = queueItem[T]{}
+	q.items = q.items[:last]
+	if last > 0 {
+		q.down(0)
+	} else {
+		q.seq = 0
+	}
+	return top.item, true
+}
+
+func (q *PriorityQueue[T]) less(i, j int) bool {
+	a, b := q.items[i], q.items[j]
+	if a.priority != b.priority {
+		return a.priority < b.priority
+	}
+	return a.seq < b.seq
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(i, parent) {
+			return
+		}
+		q.items[i], q.items[parent] = q.items[parent], q.items[i]
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T]) down(i int) {
+	n := len(q.items)
+	for {
+		smallest := i
+		if left := 2*i + 1; left < n && q.less(left, smallest) {
+			smallest = left
+		}
+		if right := 2*i + 2; right < n && q.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		q.items[i], q.items[smallest] = q.items[smallest], q.items[i]
+		i = smallest
+	}
+}
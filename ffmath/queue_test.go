@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPriorityQueueOrder(t *testing.T) {
+	t.Parallel()
+	var q PriorityQueue[string]
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("e", 5)
+	q.Push("b", 2)
+	q.Push("d", 4)
+	q.Push("neg", -1)
+
+	if q.Len() != 6 {
+		t.Fatalf("Len(): want 6, got %d", q.Len())
+	}
+	var got []string
+	for q.Len() > 0 {
+		item, ok := q.Pop()
+		if !ok {
+			t.Fatalf("Pop(): want ok with Len()=%d", q.Len())
+		}
+		got = append(got, item)
+	}
+	want := []string{"neg", "a", "b", "c", "d", "e"}
+	if !slices.Equal(got, want) {
+		t.Errorf("pop order:\nwant: %v\ngot:  %v", want, got)
+	}
+	if item, ok := q.Pop(); ok {
+		t.Errorf("Pop() on empty queue: want false, got %q, true", item)
+	}
+}
+
+func TestPriorityQueueStable(t *testing.T) {
+	t.Parallel()
+	var q PriorityQueue[int]
+	// equal priorities and duplicates should come out in insertion order
+	pushes := []struct {
+		item     int
+		priority float32
+	}{
+		{1, 2}, {2, 1}, {3, 2}, {4, 1}, {5, 2}, {2, 1}, {6, 0}, {7, 2},
+	}
+	for _, p := range pushes {
+		q.Push(p.item, p.priority)
+	}
+	var got []int
+	for q.Len() > 0 {
+		item, _ := q.Pop()
+		got = append(got, item)
+	}
+	want := []int{6, 2, 4, 2, 1, 3, 5, 7}
+	if !slices.Equal(got, want) {
+		t.Errorf("pop order:\nwant: %v\ngot:  %v", want, got)
+	}
+}
+
+func TestPriorityQueueInterleaved(t *testing.T) {
+	t.Parallel()
+	var q PriorityQueue[float32]
+	var got []float32
+	for i := range 50 {
+		// pseudo-shuffled priorities
+		p := float32((i * 37) % 50)
+		q.Push(p, p)
+		if i%3 == 2 {
+			item, _ := q.Pop()
+			got = append(got, item)
+		}
+	}
+	rest := []float32{}
+	for q.Len() > 0 {
+		item, _ := q.Pop()
+		rest = append(rest, item)
+	}
+	if !slices.IsSorted(rest) {
+		t.Errorf("remaining items not popped in order: %v", rest)
+	}
+	if len(got)+len(rest) != 50 {
+		t.Errorf("want 50 items in total, got %d", len(got)+len(rest))
+	}
+}
+
+func BenchmarkPriorityQueue(b *testing.B) {
+	var q PriorityQueue[int]
+	for b.Loop() {
+		for i := range 1000 {
+			q.Push(i, float32((i*37)%1000))
+		}
+		for q.Len() > 0 {
+			q.Pop()
+		}
+	}
+}
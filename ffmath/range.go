@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Range of numbers between "Min" and "Max", inclusive,
+// such as the range of a random spawn delay.
+//
+// Makes function signatures that take bounds self-documenting,
+// compared to passing "min" and "max" pairs around.
+//
+// Only numeric types are allowed, as [Range.Length] and [Range.Lerp]
+// need arithmetic, which the [cmp.Ordered] constraint does not allow.
+// "Min" is expected to be less than or equal to "Max".
+type Range[T Number] struct {
+	Min T
+	Max T
+}
+
+// Returns the value clamped to the range.
+//
+// See [Clamp] for details.
+func (r Range[T]) Clamp(v T) T {
+	return Clamp(v, r.Min, r.Max)
+}
+
+// Check if the value is in the range, including both "Min" and "Max".
+//
+// This matches [Range.Clamp], where the clamped value is always contained.
+// See [InRangeInclusive] for details.
+func (r Range[T]) Contains(v T) bool {
+	return InRangeInclusive(v, r.Min, r.Max)
+}
+
+// Returns the length of the range, i.e Max-Min.
+func (r Range[T]) Length() T {
+	return r.Max - r.Min
+}
+
+// Linearly interpolates from "Min" to "Max" by the factor defined in "weight".
+//
+// See [Lerp] for details.
+func (r Range[T]) Lerp(weight T) T {
+	return Lerp(r.Min, r.Max, weight)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+	r := Range[float32]{Min: 2, Max: 6}
+
+	if got := r.Length(); got != 4 {
+		t.Errorf("%v.Length(): want 4, got %v", r, got)
+	}
+
+	tests := []struct {
+		v            float32
+		wantClamp    float32
+		wantContains bool
+	}{
+		{v: 4, wantClamp: 4, wantContains: true},
+		{v: 2, wantClamp: 2, wantContains: true},
+		{v: 6, wantClamp: 6, wantContains: true},
+		{v: 1.9, wantClamp: 2, wantContains: false},
+		{v: 10, wantClamp: 6, wantContains: false},
+	}
+
+	for _, test := range tests {
+		if got := r.Clamp(test.v); got != test.wantClamp {
+			t.Errorf("%v.Clamp(%v): want %v, got %v", r, test.v, test.wantClamp, got)
+		}
+		if got := r.Contains(test.v); got != test.wantContains {
+			t.Errorf("%v.Contains(%v): want %t, got %t", r, test.v, test.wantContains, got)
+		}
+	}
+
+	lerpTests := []struct{ weight, want float32 }{
+		{weight: 0, want: 2},
+		{weight: 0.25, want: 3},
+		{weight: 1, want: 6},
+		{weight: 1.5, want: 8},
+	}
+
+	for _, test := range lerpTests {
+		if got := r.Lerp(test.weight); !EqualApprox(got, test.want) {
+			t.Errorf("%v.Lerp(%v): want %v, got %v", r, test.weight, test.want, got)
+		}
+	}
+
+	ri := Range[int]{Min: -3, Max: 3}
+	if got := ri.Clamp(7); got != 3 {
+		t.Errorf("%v.Clamp(7): want 3, got %v", ri, got)
+	}
+	if got := ri.Length(); got != 6 {
+		t.Errorf("%v.Length(): want 6, got %v", ri, got)
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Axis-aligned rectangle, defined by its top-left corner "Min" and its
+// bottom-right corner "Max" (as seen on the screen, where Y points down).
+//
+// "Min" is expected to be less than or equal to "Max" on both axes.
+type Rect struct {
+	Min Vec
+	Max Vec
+}
+
+// Get the width and height of the rectangle.
+func (r Rect) Size() Vec {
+	return r.Max.Sub(r.Min)
+}
+
+// Check if the point is inside the rectangle, including its edges.
+func (r Rect) Contains(p Vec) bool {
+	return p.X >= r.Min.X && p.X <= r.Max.X &&
+		p.Y >= r.Min.Y && p.Y <= r.Max.Y
+}
+
+// Circle defined by its center position and radius.
+type Circle struct {
+	Center Vec
+	Radius float32
+}
+
+// Check if the point is inside the circle, including its edge.
+func (c Circle) Contains(p Vec) bool {
+	return p.DistanceToSquared(c.Center) <= c.Radius*c.Radius
+}
+
+// Capsule, also known as a stadium, defined as all points within "Radius"
+// of the line segment between "A" and "B".
+//
+// Capsules are often a better approximation of a character's shape than
+// a circle, while still being cheap to test collisions against.
+type Capsule struct {
+	A      Vec
+	B      Vec
+	Radius float32
+}
+
+// Check if the point is inside the capsule, including its edge.
+//
+// This is when the distance from the point to the capsule's segment
+// is less than or equal to its radius.
+func (c Capsule) Contains(p Vec) bool {
+	return p.DistanceToSegmentSquared(c.A, c.B) <= c.Radius*c.Radius
+}
+
+// Check if the capsule and the circle overlap, including touching edges.
+func (c Capsule) IntersectsCircle(circle Circle) bool {
+	radius := c.Radius + circle.Radius
+	return circle.Center.DistanceToSegmentSquared(c.A, c.B) <= radius*radius
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestCapsuleContains(t *testing.T) {
+	t.Parallel()
+	capsule := Capsule{A: V(0, 0), B: V(10, 0), Radius: 2}
+	tests := []struct {
+		name string
+		p    Vec
+		want bool
+	}{
+		{name: "on segment", p: V(5, 0), want: true},
+		{name: "inside cylinder", p: V(5, 1.5), want: true},
+		{name: "on cylinder edge", p: V(5, -2), want: true},
+		{name: "just outside cylinder", p: V(5, 2.1), want: false},
+		{name: "inside start cap", p: V(-1.5, 1), want: true},
+		{name: "inside end cap", p: V(11.9, 0), want: true},
+		{name: "just outside end cap", p: V(12.1, 0), want: false},
+		{name: "outside cap corner", p: V(11.5, 1.5), want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := capsule.Contains(test.p); got != test.want {
+				t.Errorf("%v.Contains(%v): want %t, got %t", capsule, test.p, test.want, got)
+			}
+		})
+	}
+}
+
+func TestCapsuleIntersectsCircle(t *testing.T) {
+	t.Parallel()
+	capsule := Capsule{A: V(0, 0), B: V(10, 0), Radius: 2}
+	tests := []struct {
+		name   string
+		circle Circle
+		want   bool
+	}{
+		{name: "overlapping cylinder", circle: Circle{Center: V(5, 3), Radius: 2}, want: true},
+		{name: "touching cylinder", circle: Circle{Center: V(5, 4), Radius: 2}, want: true},
+		{name: "just outside cylinder", circle: Circle{Center: V(5, 4.1), Radius: 2}, want: false},
+		{name: "overlapping cap", circle: Circle{Center: V(-3, 0), Radius: 2}, want: true},
+		{name: "just outside cap", circle: Circle{Center: V(14.1, 0), Radius: 2}, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := capsule.IntersectsCircle(test.circle); got != test.want {
+				t.Errorf("%v.IntersectsCircle(%v): want %t, got %t", capsule, test.circle, test.want, got)
+			}
+		})
+	}
+}
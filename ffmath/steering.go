@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Separation steering force for flocking (boids), which pushes "self"
+// away from nearby neighbors to avoid crowding.
+//
+// Each neighbor within "radius" contributes a push directly away from it,
+// weighted inversely by its distance, so closer neighbors push harder.
+// Neighbors beyond "radius" and neighbors at the exact same position
+// as "self" are ignored.
+//
+// Returns {0,0} if there are no neighbors within the radius.
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func Separation(self Vec, neighbors []Vec, radius float32) Vec {
+	var force Vec
+	for _, n := range neighbors {
+		if n == self || n.DistanceToSquared(self) > radius*radius {
+			continue
+		}
+		force = force.Add(n.DirectionTo(self).Scale(1 / n.DistanceTo(self)))
+	}
+	return force
+}
+
+// Cohesion steering force for flocking (boids), which steers "self"
+// towards the centroid (average position) of its neighbors.
+//
+// The returned vector goes from "self" to the centroid, so it is stronger
+// the further away the group is. Normalize or scale it as needed before
+// combining it with other steering forces such as [Separation] and [Alignment].
+//
+// Returns {0,0} if there are no neighbors.
+func Cohesion(self Vec, neighbors []Vec) Vec {
+	if len(neighbors) == 0 {
+		return Vec{}
+	}
+	var sum Vec
+	for _, n := range neighbors {
+		sum = sum.Add(n)
+	}
+	centroid := sum.Scale(1 / float32(len(neighbors)))
+	return centroid.Sub(self)
+}
+
+// Alignment steering force for flocking (boids), which steers the velocity
+// "selfVel" towards the average velocity of its neighbors.
+//
+// The returned vector is the change needed to match the average velocity,
+// so selfVel.Add(Alignment(selfVel, neighborVels)) is the average velocity.
+//
+// Returns {0,0} if there are no neighbors.
+func Alignment(selfVel Vec, neighborVels []Vec) Vec {
+	if len(neighborVels) == 0 {
+		return Vec{}
+	}
+	var sum Vec
+	for _, v := range neighborVels {
+		sum = sum.Add(v)
+	}
+	average := sum.Scale(1 / float32(len(neighborVels)))
+	return average.Sub(selfVel)
+}
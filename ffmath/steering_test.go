@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestSeparation(t *testing.T) {
+	t.Parallel()
+	self := V(10, 10)
+
+	t.Run("single close neighbor", func(t *testing.T) {
+		got := Separation(self, []Vec{V(12, 10)}, 5)
+		if got.X >= 0 || !EqualApprox(got.Y, 0) {
+			t.Errorf("Separation: want force pointing away in -X, got %v", got)
+		}
+	})
+
+	t.Run("closer pushes harder", func(t *testing.T) {
+		near := Separation(self, []Vec{V(10, 11)}, 5)
+		far := Separation(self, []Vec{V(10, 14)}, 5)
+		if near.Y >= far.Y || far.Y >= 0 {
+			t.Errorf("Separation: want near push %v to be stronger than far push %v, both in -Y", near, far)
+		}
+	})
+
+	t.Run("outside radius", func(t *testing.T) {
+		got := Separation(self, []Vec{V(20, 10), V(10, -5), V(16, 16)}, 5)
+		if !got.IsZero() {
+			t.Errorf("Separation: want no force, got %v", got)
+		}
+	})
+
+	t.Run("balanced neighbors", func(t *testing.T) {
+		got := Separation(self, []Vec{V(8, 10), V(12, 10)}, 5)
+		if !got.IsZeroApprox() {
+			t.Errorf("Separation: want forces to cancel out, got %v", got)
+		}
+	})
+
+	t.Run("same position", func(t *testing.T) {
+		got := Separation(self, []Vec{self}, 5)
+		if !got.IsZero() {
+			t.Errorf("Separation: want no force, got %v", got)
+		}
+	})
+}
+
+func TestCohesion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		self      Vec
+		neighbors []Vec
+		want      Vec
+	}{
+		{name: "towards center", self: V(0, 0), neighbors: []Vec{V(10, 0), V(10, 10), V(20, 5)}, want: V(13.333333, 5)},
+		{name: "already at center", self: V(5, 5), neighbors: []Vec{V(0, 5), V(10, 5)}, want: V(0, 0)},
+		{name: "single neighbor", self: V(3, 3), neighbors: []Vec{V(1, 4)}, want: V(-2, 1)},
+		{name: "no neighbors", self: V(3, 3), neighbors: nil, want: V(0, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Cohesion(test.self, test.neighbors); !got.EqualApprox(test.want) {
+				t.Errorf("Cohesion(%v, %v): want %v, got %v", test.self, test.neighbors, test.want, got)
+			}
+		})
+	}
+}
+
+func TestAlignment(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		selfVel      Vec
+		neighborVels []Vec
+		want         Vec
+	}{
+		{name: "standing still", selfVel: V(0, 0), neighborVels: []Vec{V(2, 0), V(0, 2)}, want: V(1, 1)},
+		{name: "already aligned", selfVel: V(1, 1), neighborVels: []Vec{V(2, 0), V(0, 2)}, want: V(0, 0)},
+		{name: "turning around", selfVel: V(-3, 0), neighborVels: []Vec{V(3, 0), V(3, 0)}, want: V(6, 0)},
+		{name: "no neighbors", selfVel: V(1, 2), neighborVels: nil, want: V(0, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Alignment(test.selfVel, test.neighborVels)
+			if !got.EqualApprox(test.want) {
+				t.Errorf("Alignment(%v, %v): want %v, got %v", test.selfVel, test.neighborVels, test.want, got)
+			}
+			if len(test.neighborVels) == 0 {
+				return
+			}
+			var sum Vec
+			for _, v := range test.neighborVels {
+				sum = sum.Add(v)
+			}
+			average := sum.Scale(1 / float32(len(test.neighborVels)))
+			if steered := test.selfVel.Add(got); !steered.EqualApprox(average) {
+				t.Errorf("selfVel + Alignment: want average velocity %v, got %v", average, steered)
+			}
+		})
+	}
+}
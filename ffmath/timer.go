@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Timer driven by delta time, such as for cooldowns and spawn timers.
+//
+// A one-shot timer fires once and then stays done until [Timer.Reset].
+// Set "Repeating" to make it automatically restart each time it fires.
+type Timer struct {
+	// Time it takes for the timer to fire, in the same unit as "delta".
+	Duration float32
+	// Restart the timer automatically each time it fires.
+	Repeating bool
+
+	elapsed float32
+	done    bool
+}
+
+// Creates a new one-shot [Timer] with the given duration.
+//
+// Set the "Repeating" field afterwards to make it repeat.
+func NewTimer(duration float32) Timer {
+	return Timer{Duration: duration}
+}
+
+// Advances the timer and returns true on the update where it fires.
+//
+// Should be called once per frame, with "delta" being the time since the last update.
+//
+// Repeating timers keep the leftover time after firing, so they fire at the
+// right cadence even with uneven deltas. If "delta" spans several periods then
+// it still only fires once, and the missed periods are skipped.
+func (t *Timer) Update(delta float32) bool {
+	if t.done {
+		return false
+	}
+	t.elapsed += delta
+	if t.elapsed < t.Duration {
+		return false
+	}
+	if t.Repeating {
+		t.elapsed = Repeat(t.elapsed-t.Duration, t.Duration)
+	} else {
+		t.elapsed = t.Duration
+		t.done = true
+	}
+	return true
+}
+
+// Restarts the timer from zero, also allowing one-shot timers to fire again.
+func (t *Timer) Reset() {
+	t.elapsed = 0
+	t.done = false
+}
+
+// Time elapsed since the timer was started, or since it last fired
+// for repeating timers.
+func (t *Timer) Elapsed() float32 {
+	return t.elapsed
+}
+
+// True if a one-shot timer has fired. Always false for repeating timers.
+func (t *Timer) Done() bool {
+	return t.done
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTimerOneShot(t *testing.T) {
+	t.Parallel()
+	timer := NewTimer(1)
+	var fired []int
+	for i := range 10 {
+		if timer.Update(0.25) {
+			fired = append(fired, i)
+		}
+	}
+	if want := []int{3}; !slices.Equal(fired, want) {
+		t.Errorf("fired on updates: want %v, got %v", want, fired)
+	}
+	if !timer.Done() {
+		t.Errorf("Done(): want true after firing")
+	}
+
+	timer.Reset()
+	if timer.Done() || timer.Elapsed() != 0 {
+		t.Errorf("after Reset(): want Done()=false, Elapsed()=0, got %t, %v", timer.Done(), timer.Elapsed())
+	}
+	if timer.Update(0.5) {
+		t.Errorf("Update(0.5) after Reset(): want false, got true")
+	}
+	if !timer.Update(0.5) {
+		t.Errorf("Update(0.5) after Reset(): want true on second update, got false")
+	}
+}
+
+func TestTimerRepeating(t *testing.T) {
+	t.Parallel()
+	timer := NewTimer(1)
+	timer.Repeating = true
+	deltas := []float32{0.5, 0.75, 0.25, 0.25, 0.25, 0.5, 0.125, 0.375, 2.5, 0.25, 0.25}
+	// elapsed: 0.5, 1.25 (fire), 0.5, 0.75, 1 (fire), 0.5, 0.625, 1 (fire), 2.5 (fire), 0.75, 1 (fire)
+	var fired []int
+	for i, delta := range deltas {
+		if timer.Update(delta) {
+			fired = append(fired, i)
+		}
+	}
+	if want := []int{1, 4, 7, 8, 10}; !slices.Equal(fired, want) {
+		t.Errorf("fired on updates: want %v, got %v", want, fired)
+	}
+	if timer.Done() {
+		t.Errorf("Done(): want false for repeating timer")
+	}
+}
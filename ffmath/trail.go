@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+// Fixed-size history of positions, such as for motion trails and lag cameras.
+//
+// Backed by a ring buffer, so pushing never allocates and the oldest
+// position is overwritten once the trail is full.
+//
+// Create it with [NewTrail]. The zero value has a length of 0,
+// so it never stores any positions.
+type Trail struct {
+	points []Vec
+	// index of the oldest point
+	start int
+	count int
+}
+
+// Creates a new [Trail] that keeps the last "length" positions.
+//
+// It panics if length <= 0.
+func NewTrail(length int) Trail {
+	if length <= 0 {
+		panic("invalid argument to NewTrail")
+	}
+	return Trail{points: make([]Vec, length)}
+}
+
+// Number of positions currently stored, which is at most the trail's length.
+func (t *Trail) Len() int {
+	return t.count
+}
+
+// Adds the newest position, overwriting the oldest one if the trail is full.
+//
+// Does nothing if the trail has a length of 0, such as the zero value.
+func (t *Trail) Push(p Vec) {
+	if len(t.points) == 0 {
+		return
+	}
+	if t.count < len(t.points) {
+		t.points[(t.start+t.count)%len(t.points)] = p
+		t.count++
+		return
+	}
+	t.points[t.start] = p
+	t.start = (t.start + 1) % len(t.points)
+}
+
+// Get the i-th stored position, where 0 is the oldest.
+func (t *Trail) at(i int) Vec {
+	return t.points[(t.start+i)%len(t.points)]
+}
+
+// Samples a position along the stored history, where 0 is the oldest
+// position and 1 is the newest, linearly interpolating between the
+// stored positions using [Vec.Lerp].
+//
+// The stored positions are spread out evenly over the range [0, 1],
+// and "weight" is clamped to that range.
+// Returns {0,0} if the trail is empty.
+func (t *Trail) Sample(weight float32) Vec {
+	switch t.count {
+	case 0:
+		return Vec{}
+	case 1:
+		return t.at(0)
+	}
+	pos := Clamp01(weight) * float32(t.count-1)
+	i := int(pos)
+	if i >= t.count-1 {
+		return t.at(t.count - 1)
+	}
+	return t.at(i).Lerp(t.at(i+1), pos-float32(i))
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestTrail(t *testing.T) {
+	t.Parallel()
+	trail := NewTrail(3)
+	if got := trail.Sample(0.5); got != (Vec{}) {
+		t.Errorf("empty Sample(0.5): want {0,0}, got %v", got)
+	}
+
+	trail.Push(V(10, 0))
+	if got := trail.Sample(0.7); got != V(10, 0) {
+		t.Errorf("single Sample(0.7): want %v, got %v", V(10, 0), got)
+	}
+
+	// overflows the length, so V(10, 0) is dropped
+	for _, p := range []Vec{V(0, 0), V(4, 0), V(4, 8)} {
+		trail.Push(p)
+	}
+	if trail.Len() != 3 {
+		t.Fatalf("Len(): want 3, got %d", trail.Len())
+	}
+
+	tests := []struct {
+		weight float32
+		want   Vec
+	}{
+		{weight: 0, want: V(0, 0)},
+		{weight: 0.25, want: V(2, 0)},
+		{weight: 0.5, want: V(4, 0)},
+		{weight: 0.75, want: V(4, 4)},
+		{weight: 1, want: V(4, 8)},
+		{weight: -1, want: V(0, 0)},
+		{weight: 2, want: V(4, 8)},
+	}
+
+	for _, test := range tests {
+		if got := trail.Sample(test.weight); !got.EqualApprox(test.want) {
+			t.Errorf("Sample(%v): want %v, got %v", test.weight, test.want, got)
+		}
+	}
+}
+
+func TestTrailZeroValue(t *testing.T) {
+	t.Parallel()
+	var trail Trail
+	trail.Push(V(1, 2))
+	if trail.Len() != 0 {
+		t.Errorf("zero value Len() after Push: want 0, got %d", trail.Len())
+	}
+	if got := trail.Sample(1); got != (Vec{}) {
+		t.Errorf("zero value Sample(1): want {0,0}, got %v", got)
+	}
+}
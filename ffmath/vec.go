@@ -37,6 +37,12 @@ func VAngle(angle firefly.Angle) Vec {
 	}
 }
 
+// Converts a complex number to a [Vec],
+// where the real part is X and the imaginary part is Y.
+func VComplex(c complex64) Vec {
+	return Vec{X: real(c), Y: imag(c)}
+}
+
 // Convert a [Vec] to a [Point].
 //
 // The X and Y floats are truncated, meaning the floored value of positive numbers
@@ -45,6 +51,83 @@ func (v Vec) Point() firefly.Point {
 	return firefly.Point{X: int(v.X), Y: int(v.Y)}
 }
 
+// Convert a [Vec] to a complex number,
+// where X is the real part and Y is the imaginary part.
+func (v Vec) Complex() complex64 {
+	return complex(v.X, v.Y)
+}
+
+// Get a copy of the vector with the X component replaced.
+func (v Vec) WithX(x float32) Vec {
+	return Vec{X: x, Y: v.Y}
+}
+
+// Get a copy of the vector with the Y component replaced.
+func (v Vec) WithY(y float32) Vec {
+	return Vec{X: v.X, Y: y}
+}
+
+// Get a vector with the X and Y components swapped.
+func (v Vec) Swap() Vec {
+	return Vec{X: v.Y, Y: v.X}
+}
+
+// Rounding mode used when converting floats to integers, such as in [Vec.ToPoint].
+type RoundMode uint8
+
+const (
+	// Truncate towards zero, i.e floor positive values and ceil negative values.
+	RoundTrunc RoundMode = iota
+	// Round towards negative infinity.
+	RoundFloor
+	// Round towards positive infinity.
+	RoundCeil
+	// Round to the nearest integer, with halfway values rounded away from zero.
+	RoundNearest
+)
+
+// Convert a [Vec] to a [firefly.Point] using the given rounding mode.
+//
+// The choice matters for sub-pixel rendering, especially for negative values.
+// [Vec.Point] is the same as using [RoundTrunc].
+func (v Vec) ToPoint(mode RoundMode) firefly.Point {
+	switch mode {
+	case RoundFloor:
+		v = v.Floor()
+	case RoundCeil:
+		v = v.Ceil()
+	case RoundNearest:
+		v = v.Round()
+	}
+	return v.Point()
+}
+
+// True if both vectors are drawn on the same pixel, which is usually
+// the comparison wanted in pixel-art gameplay logic instead of [Vec.Equal].
+//
+// Uses the same rounding as [Vec.Point], i.e [RoundTrunc], as that is
+// what is typically used when drawing.
+// Note that truncation rounds towards zero, so all positions in the range
+// (-1, 1) are on pixel 0. Compare [Vec.ToPoint] with [RoundFloor] instead
+// if positions go negative and that matters.
+func (v Vec) SamePixel(other Vec) bool {
+	return v.Point() == other.Point()
+}
+
+// Splits a position into the whole pixel to draw at and the sub-pixel
+// remainder, such as for smooth scrolling where the remainder is carried
+// over to the next frame.
+//
+// The pixel is floored, i.e the same as [Vec.ToPoint] with [RoundFloor],
+// so the remainder is always in the range [0, 1) for both X and Y,
+// also for negative positions. For example V(-1.25, 2.5) is split into
+// the pixel (-2, 2) and the remainder V(0.75, 0.5).
+// Adding [VPoint](whole) and the remainder gives back the original position.
+func (v Vec) SplitPixel() (whole firefly.Point, remainder Vec) {
+	floored := v.Floor()
+	return floored.Point(), v.Sub(floored)
+}
+
 // Return a vector with absolute X and Y components.
 func (v Vec) Abs() Vec {
 	return Vec{X: tinymath.Abs(v.X), Y: tinymath.Abs(v.Y)}
@@ -91,6 +174,23 @@ func (v Vec) Clamp(min, max Vec) Vec {
 	return Vec{Clamp(v.X, min.X, max.X), Clamp(v.Y, min.Y, max.Y)}
 }
 
+// Get a position with both X and Y clamped between "min" and "max",
+// and true if any of the components were changed by the clamping.
+//
+// Useful for triggering feedback such as a bump sound only when
+// hitting a boundary.
+func (v Vec) ClampChecked(min, max Vec) (Vec, bool) {
+	clamped := v.Clamp(min, max)
+	return clamped, clamped != v
+}
+
+// Get a position with both X and Y clamped between 0 and 1.
+//
+// See [Saturate].
+func (v Vec) Saturate() Vec {
+	return Vec{X: Clamp01(v.X), Y: Clamp01(v.Y)}
+}
+
 // Get a position with both X and Y rounded to the nearest integer.
 func (v Vec) Round() Vec {
 	return Vec{X: tinymath.Round(v.X), Y: tinymath.Round(v.Y)}
@@ -106,11 +206,45 @@ func (v Vec) Floor() Vec {
 	return Vec{X: tinymath.Floor(v.X), Y: tinymath.Floor(v.Y)}
 }
 
+// Get a position snapped to the nearest point on a grid with the given
+// cell size "step", where the grid is offset by "origin".
+//
+// Useful for grids that aren't aligned with {0,0}, such as a tilemap
+// offset by half a tile.
+// A component of "step" that is zero leaves that component as-is.
+func (v Vec) SnappedTo(origin, step Vec) Vec {
+	return Vec{
+		X: snappedTo(v.X, origin.X, step.X),
+		Y: snappedTo(v.Y, origin.Y, step.Y),
+	}
+}
+
+func snappedTo(value, origin, step float32) float32 {
+	if step == 0 {
+		return value
+	}
+	return origin + tinymath.Round((value-origin)/step)*step
+}
+
 // Check if the position is within the screen boundaries.
 func (v Vec) InBounds() bool {
 	return v.X >= 0 && v.Y >= 0 && v.X < firefly.Width && v.Y < firefly.Height
 }
 
+// Check if both X and Y are in the half-open rectangular range [min, max).
+//
+// See [InRange] for details.
+func (v Vec) InRange(min, max Vec) bool {
+	return InRange(v.X, min.X, max.X) && InRange(v.Y, min.Y, max.Y)
+}
+
+// Check if both X and Y are in the closed rectangular range [min, max].
+//
+// See [InRangeInclusive] for details.
+func (v Vec) InRangeInclusive(min, max Vec) bool {
+	return InRangeInclusive(v.X, min.X, max.X) && InRangeInclusive(v.Y, min.Y, max.Y)
+}
+
 // Get a position where both the X and Y value are individually multiplied by the scalar factor.
 func (v Vec) Scale(factor float32) Vec {
 	return Vec{X: v.X * factor, Y: v.Y * factor}
@@ -145,12 +279,147 @@ func (v Vec) Azimuth() firefly.Angle {
 //
 // Use negative "delta" value to move away.
 func (v Vec) MoveTowards(to Vec, delta float32) Vec {
+	result, _ := v.MoveTowardsDone(to, delta)
+	return result
+}
+
+// Get a position that has moved towards "to" by the "delta" amount, the same
+// way as [Vec.MoveTowards], and true if the position reached "to".
+//
+// Useful for state machines that should transition when the movement is done.
+func (v Vec) MoveTowardsDone(to Vec, delta float32) (Vec, bool) {
 	vd := to.Sub(v)
 	dist := vd.Radius()
 	if dist <= delta || dist < Epsilon {
-		return to
+		return to, true
 	}
-	return v.Add(vd.Scale(delta / dist))
+	return v.Add(vd.Scale(delta / dist)), false
+}
+
+// Get a vector that has been shortened towards {0,0} by "rate*delta",
+// stopping at {0,0} instead of overshooting, such as for friction on a velocity.
+//
+// The direction is kept while shortening.
+// This is the same as [Vec.MoveTowards]({0,0}, rate*delta), named for intent.
+// The "rate" is in units per second and should not be negative.
+//
+// See [Decay] for more details.
+func (v Vec) Damp(rate, delta float32) Vec {
+	return v.MoveTowards(Vec{}, rate*delta)
+}
+
+// Get a position that has moved towards "to" by at most "maxDelta",
+// slowing down when arriving, such as for UI elements sliding into place.
+//
+// When further away than "slowRadius" this moves at the full "maxDelta",
+// the same as [Vec.MoveTowards]. Within "slowRadius" the step is scaled down
+// proportionally to the remaining distance, so it eases in on the target
+// without ever overshooting it. As the step keeps shrinking, the position
+// approaches "to" exponentially, and snaps to it when practically there.
+//
+// A "slowRadius" of zero or less never slows down.
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func (v Vec) MoveTowardsEased(to Vec, maxDelta, slowRadius float32) Vec {
+	step := maxDelta
+	if slowRadius > 0 {
+		if dist := v.DistanceTo(to); dist < slowRadius {
+			step *= dist / slowRadius
+		}
+	}
+	return v.MoveTowards(to, step)
+}
+
+// Moves each position towards its own target by the "delta" amount,
+// the same way as [Vec.MoveTowards], updating "positions" in place.
+//
+// Useful for swarms where each agent moves towards its own target,
+// as the whole batch is processed in one pass over contiguous memory.
+//
+// It panics if "positions" and "targets" have different lengths.
+func MoveTowardsEach(positions, targets []Vec, delta float32) {
+	if len(positions) != len(targets) {
+		panic("invalid argument to MoveTowardsEach")
+	}
+	for i, target := range targets {
+		positions[i] = positions[i].MoveTowards(target, delta)
+	}
+}
+
+// Linear interpolation between two positions by the factor defined in "weight".
+//
+// See [Lerp] for more details.
+func (v Vec) Lerp(to Vec, weight float32) Vec {
+	return Vec{X: Lerp(v.X, to.X, weight), Y: Lerp(v.Y, to.Y, weight)}
+}
+
+// Linear interpolation between two positions by the factor defined in "weight",
+// and true if the weight was outside the range [0, 1] so that the result
+// was extrapolated past "v" or "to".
+//
+// Useful for debug code that flags unintended extrapolation,
+// while [Vec.Lerp] silently allows it.
+func (v Vec) LerpExtrapolated(to Vec, weight float32) (Vec, bool) {
+	return v.Lerp(to, weight), weight < 0 || weight > 1
+}
+
+// Spherical (circular) interpolation between two vectors by the factor
+// defined in "weight", such as for blending headings.
+//
+// Unlike [Vec.Lerp], which shortens the vector midway when blending between
+// directions, this rotates along the shortest arc at a constant angular velocity
+// while linearly interpolating the length.
+//
+// Nearly identical directions behave like [Vec.Lerp]. Exactly opposite
+// directions rotate in the positive direction (see [Vec.Rotate]).
+// If either vector is zero then this falls back to [Vec.Lerp].
+//
+// Uses [tinymath] for faster but less accurate rotation, with a max error of 0.002.
+func (v Vec) Slerp(to Vec, weight float32) Vec {
+	fromLen := sqrtPrecise(v.RadiusSquared())
+	toLen := sqrtPrecise(to.RadiusSquared())
+	if fromLen == 0 || toLen == 0 {
+		return v.Lerp(to, weight)
+	}
+	angle := float32(math.Atan2(float64(v.Cross(to)), float64(v.Dot(to))))
+	length := Lerp(fromLen, toLen, weight)
+	return v.Scale(length / fromLen).Rotate(firefly.Radians(angle * weight))
+}
+
+// Bilinear interpolation between four corner positions.
+//
+// See [Bilerp] for more details.
+func BilerpVec(c00, c10, c01, c11 Vec, tx, ty float32) Vec {
+	return c00.Lerp(c10, tx).Lerp(c01.Lerp(c11, tx), ty)
+}
+
+// Performs a reverse [Vec.Lerp], returning the weight factor of the position
+// projected onto the line going through "from" and "to".
+//
+// In other words, returns the "t" such that from.Lerp(to, t) is the position
+// closest to this position. The result is not clamped:
+//
+//   - Return is between [0, 1] if the position is between "from" and "to"
+//   - Return <0 if the position is before "from"
+//   - Return >1 if the position is beyond "to"
+//
+// Returns 0 if "from" and "to" are equal.
+func (v Vec) InverseLerp(from, to Vec) float32 {
+	segment := to.Sub(from)
+	lengthSquared := segment.RadiusSquared()
+	if lengthSquared == 0 {
+		return 0
+	}
+	return v.Sub(from).Dot(segment) / lengthSquared
+}
+
+// Get a position that has been interpolated towards "to" by the factor
+// defined in "weight", but has moved at most "maxStep".
+//
+// This combines the smooth easing of [Vec.Lerp] with the safety cap of
+// [Vec.MoveTowards], so large distances doesn't cause the position to teleport.
+func (v Vec) LerpTowards(to Vec, weight, maxStep float32) Vec {
+	return v.MoveTowards(v.Lerp(to, weight), maxStep)
 }
 
 // Get the distance to another position.
@@ -165,6 +434,193 @@ func (v Vec) DistanceToSquared(to Vec) float32 {
 	return v.Sub(to).RadiusSquared()
 }
 
+// Get the normalized direction from this position towards "to".
+//
+// Returns {0,0} if both positions are the same.
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func (v Vec) DirectionTo(to Vec) Vec {
+	return to.Sub(v).Normalize()
+}
+
+// Get the position clamped to be within the circle, such as to keep
+// a cursor inside a radial menu.
+//
+// Returns the position unchanged if it is inside the circle or on its edge,
+// otherwise the nearest point on the circle's edge.
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%
+// for positions outside the circle.
+func (v Vec) ClampToCircle(center Vec, radius float32) Vec {
+	if v.DistanceToSquared(center) <= radius*radius {
+		return v
+	}
+	return center.Add(center.DirectionTo(v).Scale(radius))
+}
+
+// Get the point on the line segment between "a" and "b" that is closest
+// to this position.
+//
+// If "a" and "b" are the same point, then "a" is returned.
+func (v Vec) ClosestPointOnSegment(a, b Vec) Vec {
+	ab := b.Sub(a)
+	lenSq := ab.RadiusSquared()
+	if lenSq == 0 {
+		return a
+	}
+	t := Clamp01(v.Sub(a).Dot(ab) / lenSq)
+	return a.Add(ab.Scale(t))
+}
+
+// Get the distance from this position to the line segment between "a" and "b".
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func (v Vec) DistanceToSegment(a, b Vec) float32 {
+	return v.DistanceTo(v.ClosestPointOnSegment(a, b))
+}
+
+// Get the squared distance from this position to the line segment between
+// "a" and "b", which is simpler to calculate.
+func (v Vec) DistanceToSegmentSquared(a, b Vec) float32 {
+	return v.DistanceToSquared(v.ClosestPointOnSegment(a, b))
+}
+
+// Get the point on or inside the rectangle that is closest to this position,
+// by clamping the position into the rectangle's bounds.
+//
+// If the position is already inside the rectangle then it is returned unchanged.
+func (v Vec) ClosestPointOnRect(r Rect) Vec {
+	return v.Clamp(r.Min, r.Max)
+}
+
+// Get the distance from this position to the closest point of the rectangle.
+//
+// Returns 0 when the position is inside the rectangle.
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func (v Vec) DistanceToRect(r Rect) float32 {
+	closest := v.ClosestPointOnRect(r)
+	if closest == v {
+		// tinymath.Sqrt(0) is not exactly 0
+		return 0
+	}
+	return v.DistanceTo(closest)
+}
+
+// Get the perpendicular distance to the infinite line going through "point"
+// in the direction of "dir".
+//
+// The "dir" vector does not need to be normalized.
+// If "dir" is zero, then the distance to "point" is returned.
+//
+// See [Vec.SignedDistanceToLine] to also know which side of the line the position is on.
+func (v Vec) DistanceToLine(point, dir Vec) float32 {
+	return Abs(v.SignedDistanceToLine(point, dir))
+}
+
+// Get the signed perpendicular distance to the infinite line going through
+// "point" in the direction of "dir".
+//
+// The distance is positive when the position is on the right side of the line
+// when looking in the direction of "dir" on the screen (where Y points down),
+// and negative on the left side.
+//
+// The "dir" vector does not need to be normalized.
+// If "dir" is zero, then the (positive) distance to "point" is returned.
+func (v Vec) SignedDistanceToLine(point, dir Vec) float32 {
+	dirRadius := sqrtPrecise(dir.RadiusSquared())
+	if dirRadius == 0 {
+		return sqrtPrecise(v.DistanceToSquared(point))
+	}
+	return dir.PerpDot(v.Sub(point)) / dirRadius
+}
+
+// Get the length of the projection of this vector onto another vector,
+// also known as the scalar projection.
+//
+// This is how far along the "onto" axis this vector reaches, and is negative
+// if the vectors are pointing in opposite directions.
+// Returns 0 if "onto" is zero.
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func (v Vec) ProjectLength(onto Vec) float32 {
+	radius := onto.Radius()
+	if radius == 0 {
+		return 0
+	}
+	return v.Dot(onto) / radius
+}
+
+// Decomposes this vector into its components along "forward" and along
+// the axis perpendicular to it, such as to split a velocity into
+// "forward speed" and "sideways speed".
+//
+// The "perp" component is positive towards the right side when looking
+// along "forward", as seen on the screen (where Y points down),
+// which is the direction of forward.[Vec.Rotate90]().
+//
+// The "forward" vector does not need to be normalized.
+// If "forward" is zero, then both components are 0.
+func (v Vec) LocalTo(forward Vec) (along float32, perp float32) {
+	radius := sqrtPrecise(forward.RadiusSquared())
+	if radius == 0 {
+		return 0, 0
+	}
+	return v.Dot(forward) / radius, forward.Cross(v) / radius
+}
+
+// Get the vector with its component along "dir" removed.
+//
+// In other words, this returns the vector minus its projection onto "dir",
+// which is useful for constraining movement, e.g removing the horizontal
+// component when climbing a ladder.
+//
+// The "dir" vector does not need to be normalized.
+// If "dir" is zero, then the vector is returned as-is.
+func (v Vec) RemoveComponent(dir Vec) Vec {
+	dirRadiusSquared := dir.RadiusSquared()
+	if dirRadiusSquared == 0 {
+		return v
+	}
+	return v.Sub(dir.Scale(v.Dot(dir) / dirRadiusSquared))
+}
+
+// Get the vector reflected off a surface with the given normal,
+// like a ball bouncing off a wall without losing any energy.
+//
+// The "normal" vector does not need to be normalized.
+// If "normal" is zero, then the vector is returned as-is.
+//
+// See [Vec.Bounce] for bounces that lose energy.
+func (v Vec) Reflect(normal Vec) Vec {
+	return v.Bounce(normal, 1, 0)
+}
+
+// Get the vector bounced off a surface with the given normal,
+// where the bounce loses energy, for satisfying arcade physics.
+//
+// The vector is split into the component along the normal and the
+// tangential component along the surface.
+// The normal component is flipped and scaled by "restitution",
+// and the tangential component is scaled by 1-friction.
+//
+//   - restitution=1 and friction=0 is a perfectly elastic bounce, same as [Vec.Reflect]
+//   - restitution=0 stops all movement into and out of the surface,
+//     leaving only the sliding along it
+//   - friction=1 stops all sliding along the surface
+//
+// The "normal" vector does not need to be normalized.
+// If "normal" is zero, then the vector is returned as-is.
+func (v Vec) Bounce(normal Vec, restitution, friction float32) Vec {
+	normalRadiusSquared := normal.RadiusSquared()
+	if normalRadiusSquared == 0 {
+		return v
+	}
+	normalPart := normal.Scale(v.Dot(normal) / normalRadiusSquared)
+	tangentPart := v.Sub(normalPart)
+	return tangentPart.Scale(1 - friction).Sub(normalPart.Scale(restitution))
+}
+
 // Get a normalized vector.
 //
 // A normalized vector's [Vec.Radius] equals 1.
@@ -182,6 +638,30 @@ func (v Vec) Normalize() Vec {
 	}
 }
 
+// Get a vector with the same direction but with a radius of 1,
+// using the "fast inverse square root" from Quake III Arena.
+//
+// Multiplies by an approximated inverse square root instead of dividing
+// by a square root as [Vec.Normalize] does, which avoids the division that
+// can be slow on small CPUs. Benchmark on the target device before picking
+// one over the other, as on CPUs with fast division this can instead be slower.
+//
+// Uses [tinymath.InvSqrt] refined with one Newton-Raphson iteration,
+// giving a max deviation of ~0.2% in the resulting length,
+// compared to the average deviation of ~5% in [Vec.Normalize].
+// This is still not exact, so don't rely on [Vec.IsNormalized] being true.
+//
+// Returns {0,0} for the zero vector.
+func (v Vec) NormalizeFast() Vec {
+	squaredRadius := v.RadiusSquared()
+	if squaredRadius == 0 {
+		return Vec{}
+	}
+	inv := tinymath.InvSqrt(squaredRadius)
+	inv *= 1.5 - 0.5*squaredRadius*inv*inv
+	return Vec{X: v.X * inv, Y: v.Y * inv}
+}
+
 // Check if the radius approximately equal to 1.
 func (v Vec) IsNormalized() bool {
 	return EqualApprox(v.RadiusSquared(), 1)
@@ -192,9 +672,117 @@ func (v Vec) Dot(other Vec) float32 {
 	return v.X*other.X + v.Y*other.Y
 }
 
-// Get the cross product of two vectors
+// Writes the dot product of each vector in "vecs" against "against" into "dst",
+// such as for scoring many candidate directions in steering or utility AI.
+//
+// It panics if "dst" is shorter than "vecs".
+func DotAll(dst []float32, vecs []Vec, against Vec) {
+	dst = dst[:len(vecs)]
+	for i, v := range vecs {
+		dst[i] = v.Dot(against)
+	}
+}
+
+// Finds the vector in "vecs" that is best aligned with "against",
+// meaning the one with the highest dot product.
+//
+// Returns the index of the best vector and its dot product.
+// When multiple vectors are equally aligned, the first one is returned.
+// Returns -1 if "vecs" is empty.
+func BestByDot(vecs []Vec, against Vec) (int, float32) {
+	best := -1
+	var bestDot float32
+	for i, v := range vecs {
+		dot := v.Dot(against)
+		if best == -1 || dot > bestDot {
+			best = i
+			bestDot = dot
+		}
+	}
+	return best, bestDot
+}
+
+// Get the cross product of two vectors.
+//
+// In 2D this is the Z component of the 3D cross product,
+// which is the same as [Vec.PerpDot].
 func (v Vec) Cross(other Vec) float32 {
-	return v.X*other.Y + v.Y*other.X
+	return v.PerpDot(other)
+}
+
+// Get the perpendicular dot product of two vectors, also known as the
+// "perp dot" product or the 2D cross product.
+//
+// The result is the signed area of the parallelogram spanned by the two vectors.
+func (v Vec) PerpDot(other Vec) float32 {
+	return v.X*other.Y - v.Y*other.X
+}
+
+// True if the other vector is pointing less than 90° away from this vector.
+//
+// In other words, true if the dot product of the two vectors is positive.
+// Useful for checks like "is the enemy in front of me".
+func (v Vec) IsFacing(other Vec) bool {
+	return v.Dot(other) > 0
+}
+
+// True if the two vectors are pointing in approximately the same direction,
+// regardless of their lengths.
+//
+// The comparison is done on the normalized vectors using [Vec.EqualApprox].
+// A zero vector has no direction, so this always returns false if any of
+// the vectors are zero.
+func (v Vec) SameDirectionApprox(other Vec) bool {
+	vRadius := sqrtPrecise(v.RadiusSquared())
+	otherRadius := sqrtPrecise(other.RadiusSquared())
+	if vRadius == 0 || otherRadius == 0 {
+		return false
+	}
+	return v.Scale(1 / vRadius).EqualApprox(other.Scale(1 / otherRadius))
+}
+
+// Get the vector rotated by 90°.
+//
+// The rotation is in the same direction as [Vec.Azimuth], meaning
+// [V](1, 0).Rotate90() == [V](0, 1), which is clockwise on the screen
+// where Y points down.
+func (v Vec) Rotate90() Vec {
+	return Vec{X: -v.Y, Y: v.X}
+}
+
+// Get the vector rotated by the given angle.
+//
+// The rotation is in the same direction as [Vec.Azimuth] and [Vec.Rotate90],
+// which is clockwise on the screen where Y points down.
+//
+// Uses [tinymath] for faster but less accurate calculation, with a max error of 0.002.
+func (v Vec) Rotate(angle firefly.Angle) Vec {
+	return v.RotateSinCos(SinCos(angle))
+}
+
+// Get the vector rotated by the angle of the given precomputed sine and cosine.
+//
+// Use [SinCos] to calculate the sine and cosine once when rotating many vectors
+// by the same angle, such as a whole particle system.
+// Otherwise this gives the same result as [Vec.Rotate].
+func (v Vec) RotateSinCos(sin, cos float32) Vec {
+	return Vec{
+		X: v.X*cos - v.Y*sin,
+		Y: v.X*sin + v.Y*cos,
+	}
+}
+
+// Rotates and scales the vector by treating both vectors as complex numbers
+// and multiplying them.
+//
+// If "rot" is a unit vector then this is a pure rotation by the azimuth of "rot",
+// e.g [V](0, 1) rotates by 90° the same way as [Vec.Rotate90].
+// This allows composing rotations without any trigonometry.
+func (v Vec) MulComplex(rot Vec) Vec {
+	return Vec{
+		X: v.X*rot.X - v.Y*rot.Y,
+		Y: v.X*rot.Y + v.Y*rot.X,
+	}
 }
 
 // True if the other vector has exactly the same float values.
@@ -208,13 +796,35 @@ func (v Vec) Equal(other Vec) bool {
 // True if the other vector has approximately the same float values.
 //
 // The comparison done here is to see if the difference between the positions
-// are less than [Epsilon].
+// are less than [DefaultEpsilon].
 //
 // Infinite values with the same sign (+/-) are considered equal.
 func (v Vec) EqualApprox(other Vec) bool {
 	return EqualApprox(v.X, other.X) && EqualApprox(v.Y, other.Y)
 }
 
+// True if the other vector is approximately the same, using a relative tolerance
+// based on the larger of the two vectors' lengths.
+//
+// The vectors are considered equal if the distance between them is at most
+// "relTol" times the length of the longer vector.
+//
+// Prefer this over [Vec.EqualApprox] when the vectors can have large coordinates,
+// where a small component (e.g Y in {100000, 1}) would otherwise be held to
+// a far stricter tolerance than the vector's overall magnitude warrants.
+func (v Vec) EqualApproxRel(other Vec, relTol float32) bool {
+	maxRadiusSquared := max(v.RadiusSquared(), other.RadiusSquared())
+	return v.DistanceToSquared(other) <= relTol*relTol*maxRadiusSquared
+}
+
+// True if the vector is exactly equal to {0,0}, without any tolerance.
+//
+// Both +0.0 and -0.0 are considered zero.
+// Use [Vec.IsZeroApprox] to allow for rounding errors.
+func (v Vec) IsZero() bool {
+	return IsZero(v.X) && IsZero(v.Y)
+}
+
 // True if the vector is approximately equal to {0,0}.
 //
 // This function is faster than [Vec.EqualApprox]([V](0, 0)).
@@ -226,3 +836,32 @@ func (v Vec) IsZeroApprox() bool {
 func (v Vec) IsFinite() bool {
 	return IsFinite(v.X) && IsFinite(v.Y)
 }
+
+// Adds a position, modifying the vector in place.
+//
+// This is the in-place variant of [Vec.Add], for hot loops such as
+// physics integrators where escape analysis may fail to keep the returned
+// values off the heap.
+// It is safe for "rhs" to be a copy of the vector itself, e.g v.AddInPlace(*v),
+// as "rhs" is passed by value.
+func (v *Vec) AddInPlace(rhs Vec) {
+	v.X += rhs.X
+	v.Y += rhs.Y
+}
+
+// Multiplies both X and Y by the scalar factor, modifying the vector in place.
+//
+// This is the in-place variant of [Vec.Scale].
+func (v *Vec) ScaleInPlace(factor float32) {
+	v.X *= factor
+	v.Y *= factor
+}
+
+// Normalizes the vector, modifying it in place.
+//
+// This is the in-place variant of [Vec.Normalize].
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func (v *Vec) NormalizeInPlace() {
+	*v = v.Normalize()
+}
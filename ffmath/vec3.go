@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "github.com/orsinium-labs/tinymath"
+
+// Utility type for dealing with float-based 3D positions.
+//
+// Useful for games that fake depth, such as keeping track of the jump height
+// or parallax layer alongside the on-screen X and Y.
+type Vec3 struct {
+	X float32
+	Y float32
+	Z float32
+}
+
+// Shortcut for creating a [Vec3].
+func V3(x, y, z float32) Vec3 {
+	return Vec3{X: x, Y: y, Z: z}
+}
+
+// Projects the vector onto the XY plane by dropping the Z component.
+func (v Vec3) XY() Vec {
+	return Vec{X: v.X, Y: v.Y}
+}
+
+// Adds a position.
+func (v Vec3) Add(rhs Vec3) Vec3 {
+	return Vec3{X: v.X + rhs.X, Y: v.Y + rhs.Y, Z: v.Z + rhs.Z}
+}
+
+// Subtract a position.
+func (v Vec3) Sub(rhs Vec3) Vec3 {
+	return Vec3{X: v.X - rhs.X, Y: v.Y - rhs.Y, Z: v.Z - rhs.Z}
+}
+
+// Get a position where X, Y, and Z are individually multiplied by the scalar factor.
+func (v Vec3) Scale(factor float32) Vec3 {
+	return Vec3{X: v.X * factor, Y: v.Y * factor, Z: v.Z * factor}
+}
+
+// Get the dot product of two vectors
+func (v Vec3) Dot(other Vec3) float32 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
+}
+
+// Get the cross product of two vectors.
+//
+// The result is perpendicular to both vectors.
+func (v Vec3) Cross(other Vec3) Vec3 {
+	return Vec3{
+		X: v.Y*other.Z - v.Z*other.Y,
+		Y: v.Z*other.X - v.X*other.Z,
+		Z: v.X*other.Y - v.Y*other.X,
+	}
+}
+
+// Length returns the vector length (aka magnitude).
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func (v Vec3) Length() float32 {
+	return tinymath.Sqrt(v.LengthSquared())
+}
+
+// Get the squared vector length (aka squared magnitude), which is simpler to calculate.
+func (v Vec3) LengthSquared() float32 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+}
+
+// Get a normalized vector.
+//
+// A normalized vector's [Vec3.Length] equals 1.
+//
+// Uses [tinymath] for faster but less accurate calculation, with an average deviation of ~5%.
+func (v Vec3) Normalize() Vec3 {
+	squaredLength := v.LengthSquared()
+	if squaredLength == 0 {
+		return Vec3{}
+	}
+	return v.Scale(1 / tinymath.Sqrt(squaredLength))
+}
+
+// Linear interpolation between two positions by the factor defined in "weight".
+//
+// See [Lerp] for more details.
+func (v Vec3) Lerp(to Vec3, weight float32) Vec3 {
+	return Vec3{
+		X: Lerp(v.X, to.X, weight),
+		Y: Lerp(v.Y, to.Y, weight),
+		Z: Lerp(v.Z, to.Z, weight),
+	}
+}
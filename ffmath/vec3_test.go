@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "testing"
+
+func TestVec3Cross(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		a, b Vec3
+		want Vec3
+	}{
+		{a: V3(1, 0, 0), b: V3(0, 1, 0), want: V3(0, 0, 1)},
+		{a: V3(0, 1, 0), b: V3(1, 0, 0), want: V3(0, 0, -1)},
+		{a: V3(2, 2, 2), b: V3(3, 3, 3), want: V3(0, 0, 0)},
+		{a: V3(1, 2, 3), b: V3(4, 5, 6), want: V3(-3, 6, -3)},
+	}
+
+	for _, test := range tests {
+		got := test.a.Cross(test.b)
+		if got != test.want {
+			t.Errorf("%v.Cross(%v)\nwant: %v\ngot:  %v", test.a, test.b, test.want, got)
+		}
+		if dot := got.Dot(test.a); dot != 0 {
+			t.Errorf("%v.Cross(%v) not orthogonal to %v, dot product: %v", test.a, test.b, test.a, dot)
+		}
+		if dot := got.Dot(test.b); dot != 0 {
+			t.Errorf("%v.Cross(%v) not orthogonal to %v, dot product: %v", test.a, test.b, test.b, dot)
+		}
+	}
+}
+
+func TestVec3Normalize(t *testing.T) {
+	t.Parallel()
+	tests := []Vec3{
+		V3(4, 0, 0),
+		V3(0, -16, 0),
+		V3(2, 3, 6),
+		V3(-1, 5, 0.5),
+	}
+
+	for _, v := range tests {
+		got := v.Normalize()
+		// tinymath.Sqrt has a deviation of ~5%
+		if length := got.LengthSquared(); length < 0.9 || length > 1.1 {
+			t.Errorf("%v.Normalize() = %v, want length ~1, got %v", v, got, length)
+		}
+		if got.Dot(v) <= 0 {
+			t.Errorf("%v.Normalize() = %v, not pointing in the same direction", v, got)
+		}
+	}
+
+	if got := (Vec3{}).Normalize(); got != (Vec3{}) {
+		t.Errorf("Vec3{}.Normalize(): want %v, got %v", Vec3{}, got)
+	}
+}
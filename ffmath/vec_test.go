@@ -0,0 +1,1016 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"testing"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+)
+
+func TestVecPerpDot(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		a, b Vec
+		want float32
+	}{
+		{name: "unit x cross unit y", a: V(1, 0), b: V(0, 1), want: 1},
+		{name: "unit y cross unit x", a: V(0, 1), b: V(1, 0), want: -1},
+		{name: "parallel", a: V(2, 2), b: V(3, 3), want: 0},
+		{name: "parallelogram", a: V(3, 0), b: V(1, 2), want: 6},
+		{name: "negative area", a: V(1, 2), b: V(3, 0), want: -6},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.a.PerpDot(test.b)
+			if got != test.want {
+				t.Errorf("%v.PerpDot(%v): want %v, got %v", test.a, test.b, test.want, got)
+			}
+			if cross := test.a.Cross(test.b); cross != got {
+				t.Errorf("%v.Cross(%v): want %v, got %v", test.a, test.b, got, cross)
+			}
+		})
+	}
+}
+
+func TestVecIsFacing(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		other Vec
+		want  bool
+	}{
+		{name: "front", other: V(10, 0), want: true},
+		{name: "front diagonal", other: V(1, 5), want: true},
+		{name: "side", other: V(0, 1), want: false},
+		{name: "other side", other: V(0, -1), want: false},
+		{name: "behind", other: V(-1, 0.5), want: false},
+	}
+
+	forward := V(1, 0)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := forward.IsFacing(test.other)
+			if got != test.want {
+				t.Errorf("%v.IsFacing(%v): want %t, got %t", forward, test.other, test.want, got)
+			}
+		})
+	}
+}
+
+func TestVecSameDirectionApprox(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		a, b Vec
+		want bool
+	}{
+		{name: "same", a: V(1, 1), b: V(1, 1), want: true},
+		{name: "scaled", a: V(1, 1), b: V(3, 3), want: true},
+		{name: "side", a: V(1, 0), b: V(0, 1), want: false},
+		{name: "behind", a: V(1, 2), b: V(-1, -2), want: false},
+		{name: "zero", a: V(1, 2), b: V(0, 0), want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.a.SameDirectionApprox(test.b)
+			if got != test.want {
+				t.Errorf("%v.SameDirectionApprox(%v): want %t, got %t", test.a, test.b, test.want, got)
+			}
+		})
+	}
+}
+
+func TestVecSignedDistanceToLine(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		v          Vec
+		point, dir Vec
+		want       float32
+	}{
+		{name: "on line", v: V(5, 2), point: V(0, 2), dir: V(1, 0), want: 0},
+		{name: "on line behind point", v: V(-5, 2), point: V(0, 2), dir: V(3, 0), want: 0},
+		{name: "right of line", v: V(5, 5), point: V(0, 2), dir: V(1, 0), want: 3},
+		{name: "left of line", v: V(5, -1), point: V(0, 2), dir: V(1, 0), want: -3},
+		{name: "diagonal", v: V(0, 2), point: V(0, 0), dir: V(1, 1), want: 1.4142135},
+		{name: "zero dir", v: V(3, 4), point: V(0, 0), dir: V(0, 0), want: 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.SignedDistanceToLine(test.point, test.dir)
+			if !EqualApprox(got, test.want) {
+				t.Errorf("%v.SignedDistanceToLine(%v, %v): want %v, got %v", test.v, test.point, test.dir, test.want, got)
+			}
+			if got := test.v.DistanceToLine(test.point, test.dir); !EqualApprox(got, Abs(test.want)) {
+				t.Errorf("%v.DistanceToLine(%v, %v): want %v, got %v", test.v, test.point, test.dir, Abs(test.want), got)
+			}
+		})
+	}
+}
+
+func TestVecMulComplex(t *testing.T) {
+	t.Parallel()
+	tests := []Vec{V(1, 0), V(0, 1), V(3, -2), V(-5, 7.5)}
+	rot90 := V(0, 1)
+
+	for _, v := range tests {
+		want := v.Rotate90()
+		got := v.MulComplex(rot90)
+		if got != want {
+			t.Errorf("%v.MulComplex(%v): want %v, got %v", v, rot90, want, got)
+		}
+		if gotComplex := VComplex(v.Complex() * rot90.Complex()); gotComplex != want {
+			t.Errorf("VComplex(%v * %v): want %v, got %v", v, rot90, want, gotComplex)
+		}
+	}
+
+	if got := V(1, 0).Rotate90(); got != V(0, 1) {
+		t.Errorf("V(1, 0).Rotate90(): want %v, got %v", V(0, 1), got)
+	}
+}
+
+func TestVecRemoveComponent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		v    Vec
+		dir  Vec
+		want Vec
+	}{
+		{name: "remove X", v: V(3, 4), dir: V(1, 0), want: V(0, 4)},
+		{name: "remove X scaled dir", v: V(3, 4), dir: V(-5, 0), want: V(0, 4)},
+		{name: "remove Y", v: V(3, 4), dir: V(0, 2), want: V(3, 0)},
+		{name: "remove diagonal", v: V(2, 0), dir: V(1, 1), want: V(1, -1)},
+		{name: "perpendicular", v: V(1, -1), dir: V(1, 1), want: V(1, -1)},
+		{name: "zero dir", v: V(3, 4), dir: V(0, 0), want: V(3, 4)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.RemoveComponent(test.dir)
+			if !got.EqualApprox(test.want) {
+				t.Errorf("%v.RemoveComponent(%v): want %v, got %v", test.v, test.dir, test.want, got)
+			}
+		})
+	}
+}
+
+func TestVecProjectLength(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		v    Vec
+		onto Vec
+		want float32
+	}{
+		{name: "aligned", v: V(3, 0), onto: V(2, 0), want: 3},
+		{name: "partially aligned", v: V(3, 5), onto: V(2, 0), want: 3},
+		{name: "perpendicular", v: V(0, 5), onto: V(2, 0), want: 0},
+		{name: "opposite", v: V(0, -7), onto: V(0, 4), want: -7},
+		{name: "zero onto", v: V(3, 4), onto: V(0, 0), want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.ProjectLength(test.onto)
+			if !EqualApprox(got, test.want) {
+				t.Errorf("%v.ProjectLength(%v): want %v, got %v", test.v, test.onto, test.want, got)
+			}
+		})
+	}
+}
+
+func TestVecLerpExtrapolated(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		weight           float32
+		want             Vec
+		wantExtrapolated bool
+	}{
+		{name: "start", weight: 0, want: V(2, 4), wantExtrapolated: false},
+		{name: "middle", weight: 0.5, want: V(4, 6), wantExtrapolated: false},
+		{name: "end", weight: 1, want: V(6, 8), wantExtrapolated: false},
+		{name: "before start", weight: -0.5, want: V(0, 2), wantExtrapolated: true},
+		{name: "past end", weight: 1.5, want: V(8, 10), wantExtrapolated: true},
+	}
+
+	from, to := V(2, 4), V(6, 8)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, extrapolated := from.LerpExtrapolated(to, test.weight)
+			if !got.EqualApprox(test.want) || extrapolated != test.wantExtrapolated {
+				t.Errorf("%v.LerpExtrapolated(%v, %v): want (%v, %v), got (%v, %v)",
+					from, to, test.weight, test.want, test.wantExtrapolated, got, extrapolated)
+			}
+			if lerped := from.Lerp(to, test.weight); lerped != got {
+				t.Errorf("%v.LerpExtrapolated(%v, %v) = %v, want same as Lerp %v",
+					from, to, test.weight, got, lerped)
+			}
+		})
+	}
+}
+
+func TestVecLerpTowards(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		v, to   Vec
+		weight  float32
+		maxStep float32
+		want    Vec
+	}{
+		{name: "below max step", v: V(0, 0), to: V(16, 0), weight: 0.25, maxStep: 8, want: V(4, 0)},
+		{name: "above max step", v: V(0, 0), to: V(0, 64), weight: 0.5, maxStep: 4, want: V(0, 4)},
+		{name: "at max step", v: V(0, 0), to: V(-16, 0), weight: 0.25, maxStep: 4, want: V(-4, 0)},
+		{name: "full weight", v: V(1, 1), to: V(3, 1), weight: 1, maxStep: 8, want: V(3, 1)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.LerpTowards(test.to, test.weight, test.maxStep)
+			if !got.EqualApprox(test.want) {
+				t.Errorf("%v.LerpTowards(%v, %v, %v): want %v, got %v",
+					test.v, test.to, test.weight, test.maxStep, test.want, got)
+			}
+		})
+	}
+}
+
+func TestVecClampChecked(t *testing.T) {
+	t.Parallel()
+	min, max := V(0, 0), V(10, 10)
+	tests := []struct {
+		name        string
+		v           Vec
+		want        Vec
+		wantClamped bool
+	}{
+		{name: "no clamp", v: V(5, 5), want: V(5, 5), wantClamped: false},
+		{name: "on boundary", v: V(0, 10), want: V(0, 10), wantClamped: false},
+		{name: "X only", v: V(-3, 5), want: V(0, 5), wantClamped: true},
+		{name: "Y only", v: V(5, 12), want: V(5, 10), wantClamped: true},
+		{name: "both", v: V(15, -1), want: V(10, 0), wantClamped: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, gotClamped := test.v.ClampChecked(min, max)
+			if got != test.want || gotClamped != test.wantClamped {
+				t.Errorf("%v.ClampChecked(%v, %v)\nwant: %v, %t\ngot:  %v, %t",
+					test.v, min, max, test.want, test.wantClamped, got, gotClamped)
+			}
+		})
+	}
+}
+
+func TestVecInverseLerp(t *testing.T) {
+	t.Parallel()
+	from, to := V(2, 2), V(6, 2)
+	tests := []struct {
+		name string
+		v    Vec
+		want float32
+	}{
+		{name: "at from", v: from, want: 0},
+		{name: "at to", v: to, want: 1},
+		{name: "middle", v: V(4, 2), want: 0.5},
+		{name: "off the line", v: V(3, -10), want: 0.25},
+		{name: "before from", v: V(0, 2), want: -0.5},
+		{name: "beyond to", v: V(10, 5), want: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.InverseLerp(from, to)
+			if !EqualApprox(got, test.want) {
+				t.Errorf("%v.InverseLerp(%v, %v): want %v, got %v", test.v, from, to, test.want, got)
+			}
+		})
+	}
+}
+
+func TestVecRotateSinCos(t *testing.T) {
+	t.Parallel()
+	vecs := []Vec{V(1, 0), V(0, 1), V(3, -2), V(-5, 7.5)}
+	degrees := []float32{0, 30, 90, 180, 270, -45}
+
+	for _, deg := range degrees {
+		angle := firefly.Degrees(deg)
+		sin, cos := SinCos(angle)
+		for _, v := range vecs {
+			want := v.Rotate(angle)
+			got := v.RotateSinCos(sin, cos)
+			if got != want {
+				t.Errorf("%v.RotateSinCos(SinCos(%v°)): want %v, got %v", v, deg, want, got)
+			}
+		}
+	}
+
+	// tinymath has a max error of 0.002
+	if got, want := V(2, 0).Rotate(firefly.Degrees(90)), V(0, 2); got.Sub(want).RadiusSquared() > 0.0001 {
+		t.Errorf("V(2, 0).Rotate(90°): want %v, got %v", want, got)
+	}
+	if got, want := V(3, -2).Rotate(firefly.Degrees(90)), V(3, -2).Rotate90(); got.Sub(want).RadiusSquared() > 0.0001 {
+		t.Errorf("V(3, -2).Rotate(90°): want %v, got %v", want, got)
+	}
+}
+
+func BenchmarkVecRotateSinCos(b *testing.B) {
+	vecs := make([]Vec, 1000)
+	for i := range vecs {
+		vecs[i] = V(float32(i), float32(-i))
+	}
+	angle := firefly.Degrees(30)
+
+	for b.Loop() {
+		sin, cos := SinCos(angle)
+		for i := range vecs {
+			vecs[i] = vecs[i].RotateSinCos(sin, cos)
+		}
+	}
+}
+
+func BenchmarkVecRotate(b *testing.B) {
+	vecs := make([]Vec, 1000)
+	for i := range vecs {
+		vecs[i] = V(float32(i), float32(-i))
+	}
+	angle := firefly.Degrees(30)
+
+	for b.Loop() {
+		for i := range vecs {
+			vecs[i] = vecs[i].Rotate(angle)
+		}
+	}
+}
+
+func TestVecSnappedTo(t *testing.T) {
+	t.Parallel()
+	origin, step := V(8, 8), V(16, 16)
+	tests := []struct {
+		v    Vec
+		want Vec
+	}{
+		{v: V(8, 8), want: V(8, 8)},
+		{v: V(1, 1), want: V(8, 8)},
+		{v: V(15, 17), want: V(8, 24)},
+		{v: V(17, 31), want: V(24, 24)},
+		{v: V(-1, -9), want: V(-8, -8)},
+		{v: V(-20, 100), want: V(-24, 104)},
+	}
+
+	for _, test := range tests {
+		got := test.v.SnappedTo(origin, step)
+		if got != test.want {
+			t.Errorf("%v.SnappedTo(%v, %v): want %v, got %v", test.v, origin, step, test.want, got)
+		}
+	}
+
+	if got, want := V(3.3, 5.5).SnappedTo(V(0, 0), V(0, 2)), V(3.3, 6); got != want {
+		t.Errorf("V(3.3, 5.5).SnappedTo(V(0, 0), V(0, 2)): want %v, got %v", want, got)
+	}
+}
+
+func TestVecInPlace(t *testing.T) {
+	t.Parallel()
+	vecs := []Vec{V(0, 0), V(1, 0), V(3, -4), V(-5, 7.5)}
+
+	for _, v := range vecs {
+		got := v
+		got.AddInPlace(V(2, -3))
+		if want := v.Add(V(2, -3)); got != want {
+			t.Errorf("%v.AddInPlace(%v): want %v, got %v", v, V(2, -3), want, got)
+		}
+
+		got = v
+		got.AddInPlace(got)
+		if want := v.Add(v); got != want {
+			t.Errorf("%v.AddInPlace(itself): want %v, got %v", v, want, got)
+		}
+
+		got = v
+		got.ScaleInPlace(-2.5)
+		if want := v.Scale(-2.5); got != want {
+			t.Errorf("%v.ScaleInPlace(-2.5): want %v, got %v", v, want, got)
+		}
+
+		got = v
+		got.NormalizeInPlace()
+		if want := v.Normalize(); got != want {
+			t.Errorf("%v.NormalizeInPlace(): want %v, got %v", v, want, got)
+		}
+	}
+}
+
+func BenchmarkVecIntegrate(b *testing.B) {
+	positions := make([]Vec, 1000)
+	velocities := make([]Vec, 1000)
+	for i := range velocities {
+		velocities[i] = V(float32(i), 1)
+	}
+	gravity := V(0, 9.8)
+	const delta = 1. / 60
+
+	for b.Loop() {
+		for i := range positions {
+			velocities[i] = velocities[i].Add(gravity.Scale(delta))
+			positions[i] = positions[i].Add(velocities[i].Scale(delta))
+		}
+	}
+}
+
+func BenchmarkVecIntegrateInPlace(b *testing.B) {
+	positions := make([]Vec, 1000)
+	velocities := make([]Vec, 1000)
+	for i := range velocities {
+		velocities[i] = V(float32(i), 1)
+	}
+	gravity := V(0, 9.8)
+	const delta = 1. / 60
+
+	for b.Loop() {
+		for i := range positions {
+			velocities[i].AddInPlace(gravity.Scale(delta))
+			positions[i].AddInPlace(velocities[i].Scale(delta))
+		}
+	}
+}
+
+func TestVecMoveTowardsDone(t *testing.T) {
+	t.Parallel()
+	got, done := V(0, 0).MoveTowardsDone(V(0, 4), 5)
+	if got != V(0, 4) || !done {
+		t.Errorf("V(0, 0).MoveTowardsDone(V(0, 4), 5)\nwant: %v, true\ngot:  %v, %t", V(0, 4), got, done)
+	}
+
+	got, done = V(3, 3).MoveTowardsDone(V(3, 3), 5)
+	if got != V(3, 3) || !done {
+		t.Errorf("V(3, 3).MoveTowardsDone(V(3, 3), 5)\nwant: %v, true\ngot:  %v, %t", V(3, 3), got, done)
+	}
+
+	value := V(0, 0)
+	var steps int
+	for done = false; !done; steps++ {
+		value, done = value.MoveTowardsDone(V(16, 0), 8)
+	}
+	if value != V(16, 0) || steps != 2 {
+		t.Errorf("V(0, 0).MoveTowardsDone(V(16, 0), 8) repeated\nwant: %v after 2 steps\ngot:  %v after %d steps", V(16, 0), value, steps)
+	}
+}
+
+func TestVecWithXY(t *testing.T) {
+	t.Parallel()
+	v := V(3, -4)
+	if got, want := v.WithX(10), V(10, -4); got != want {
+		t.Errorf("%v.WithX(10): want %v, got %v", v, want, got)
+	}
+	if got, want := v.WithY(0), V(3, 0); got != want {
+		t.Errorf("%v.WithY(0): want %v, got %v", v, want, got)
+	}
+	if got, want := v.Swap(), V(-4, 3); got != want {
+		t.Errorf("%v.Swap(): want %v, got %v", v, want, got)
+	}
+}
+
+func TestVecSplitPixel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		v             Vec
+		wantWhole     firefly.Point
+		wantRemainder Vec
+	}{
+		{name: "whole", v: V(3, 4), wantWhole: firefly.P(3, 4), wantRemainder: V(0, 0)},
+		{name: "positive", v: V(3.25, 4.75), wantWhole: firefly.P(3, 4), wantRemainder: V(0.25, 0.75)},
+		{name: "negative", v: V(-1.25, -0.5), wantWhole: firefly.P(-2, -1), wantRemainder: V(0.75, 0.5)},
+		{name: "mixed", v: V(-1.25, 2.5), wantWhole: firefly.P(-2, 2), wantRemainder: V(0.75, 0.5)},
+		{name: "negative whole", v: V(-3, -4), wantWhole: firefly.P(-3, -4), wantRemainder: V(0, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			whole, remainder := test.v.SplitPixel()
+			if whole != test.wantWhole || !remainder.EqualApprox(test.wantRemainder) {
+				t.Errorf("%v.SplitPixel(): want (%v, %v), got (%v, %v)",
+					test.v, test.wantWhole, test.wantRemainder, whole, remainder)
+			}
+			if got := VPoint(whole).Add(remainder); !got.EqualApprox(test.v) {
+				t.Errorf("%v.SplitPixel(): whole + remainder = %v, want %v", test.v, got, test.v)
+			}
+		})
+	}
+}
+
+func TestVecEqualApproxRel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		a, b       Vec
+		relTol     float32
+		want       bool
+		wantApprox bool
+	}{
+		{name: "equal", a: V(1, 2), b: V(1, 2), relTol: 0.00001, want: true, wantApprox: true},
+		{name: "zero", a: V(0, 0), b: V(0, 0), relTol: 0.00001, want: true, wantApprox: true},
+		{name: "small diff", a: V(1, 2), b: V(1, 2.5), relTol: 0.00001, want: false, wantApprox: false},
+		{name: "large coordinates", a: V(100000, 1), b: V(100000, 1.5), relTol: 0.00001, want: true, wantApprox: false},
+		{name: "large coordinates large diff", a: V(100000, 1), b: V(100000, 5), relTol: 0.00001, want: false, wantApprox: false},
+		{name: "loose tolerance", a: V(10, 0), b: V(10, 0.9), relTol: 0.1, want: true, wantApprox: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.a.EqualApproxRel(test.b, test.relTol); got != test.want {
+				t.Errorf("%v.EqualApproxRel(%v, %v): want %t, got %t", test.a, test.b, test.relTol, test.want, got)
+			}
+			if got := test.a.EqualApprox(test.b); got != test.wantApprox {
+				t.Errorf("%v.EqualApprox(%v): want %t, got %t", test.a, test.b, test.wantApprox, got)
+			}
+		})
+	}
+}
+
+func TestVecToPoint(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		v    Vec
+		mode RoundMode
+		want firefly.Point
+	}{
+		{name: "trunc negative", v: V(-1.5, -2.3), mode: RoundTrunc, want: firefly.P(-1, -2)},
+		{name: "floor negative", v: V(-1.5, -2.3), mode: RoundFloor, want: firefly.P(-2, -3)},
+		{name: "ceil negative", v: V(-1.5, -2.3), mode: RoundCeil, want: firefly.P(-1, -2)},
+		{name: "nearest negative", v: V(-1.5, -2.3), mode: RoundNearest, want: firefly.P(-2, -2)},
+		{name: "trunc positive", v: V(1.5, 2.3), mode: RoundTrunc, want: firefly.P(1, 2)},
+		{name: "floor positive", v: V(1.5, 2.3), mode: RoundFloor, want: firefly.P(1, 2)},
+		{name: "ceil positive", v: V(1.5, 2.3), mode: RoundCeil, want: firefly.P(2, 3)},
+		{name: "nearest positive", v: V(1.5, 2.3), mode: RoundNearest, want: firefly.P(2, 2)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.ToPoint(test.mode)
+			if got != test.want {
+				t.Errorf("%v.ToPoint(%v): want %v, got %v", test.v, test.mode, test.want, got)
+			}
+		})
+	}
+
+	if got, want := V(-1.5, -2.3).Point(), V(-1.5, -2.3).ToPoint(RoundTrunc); got != want {
+		t.Errorf("V(-1.5, -2.3).Point(): want %v, got %v", want, got)
+	}
+}
+
+func TestVecDistanceToRect(t *testing.T) {
+	t.Parallel()
+	rect := Rect{Min: V(0, 0), Max: V(10, 5)}
+	tests := []struct {
+		name        string
+		v           Vec
+		wantClosest Vec
+		wantDist    float32
+	}{
+		{name: "inside", v: V(3, 2), wantClosest: V(3, 2), wantDist: 0},
+		{name: "on edge", v: V(10, 2), wantClosest: V(10, 2), wantDist: 0},
+		{name: "outside left face", v: V(-4, 2), wantClosest: V(0, 2), wantDist: 4},
+		{name: "outside bottom face", v: V(7, 9), wantClosest: V(7, 5), wantDist: 4},
+		{name: "beyond top-left corner", v: V(-3, -4), wantClosest: V(0, 0), wantDist: 5},
+		{name: "beyond bottom-right corner", v: V(13, 9), wantClosest: V(10, 5), wantDist: 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.v.ClosestPointOnRect(rect); got != test.wantClosest {
+				t.Errorf("%v.ClosestPointOnRect(%v): want %v, got %v", test.v, rect, test.wantClosest, got)
+			}
+			// tinymath.Sqrt has an average deviation of ~5%
+			if got := test.v.DistanceToRect(rect); Abs(got-test.wantDist) > test.wantDist*0.05 {
+				t.Errorf("%v.DistanceToRect(%v): want %v, got %v", test.v, rect, test.wantDist, got)
+			}
+		})
+	}
+}
+
+func TestVecClosestPointOnSegment(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		v    Vec
+		a, b Vec
+		want Vec
+	}{
+		{name: "above middle", v: V(5, 3), a: V(0, 0), b: V(10, 0), want: V(5, 0)},
+		{name: "before start", v: V(-4, 2), a: V(0, 0), b: V(10, 0), want: V(0, 0)},
+		{name: "after end", v: V(14, -2), a: V(0, 0), b: V(10, 0), want: V(10, 0)},
+		{name: "diagonal", v: V(0, 4), a: V(0, 0), b: V(4, 4), want: V(2, 2)},
+		{name: "zero length", v: V(3, 4), a: V(1, 1), b: V(1, 1), want: V(1, 1)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.v.ClosestPointOnSegment(test.a, test.b); !got.EqualApprox(test.want) {
+				t.Errorf("%v.ClosestPointOnSegment(%v, %v): want %v, got %v", test.v, test.a, test.b, test.want, got)
+			}
+		})
+	}
+}
+
+func TestVecClampToCircle(t *testing.T) {
+	t.Parallel()
+	center := V(10, 10)
+	tests := []struct {
+		name string
+		v    Vec
+		want Vec
+	}{
+		{name: "center", v: V(10, 10), want: V(10, 10)},
+		{name: "inside", v: V(12, 9), want: V(12, 9)},
+		{name: "on boundary", v: V(10, 14), want: V(10, 14)},
+		{name: "on boundary diagonal", v: V(13.2, 7.6), want: V(13.2, 7.6)},
+		{name: "outside right", v: V(30, 10), want: V(14, 10)},
+		{name: "outside up", v: V(10, -6), want: V(10, 6)},
+		{name: "outside diagonal", v: V(40, 50), want: V(12.4, 13.2)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.ClampToCircle(center, 4)
+			// tinymath.Sqrt has an average deviation of ~5%
+			if !got.EqualApproxRel(test.want, 0.05) {
+				t.Errorf("%v.ClampToCircle(%v, 4): want %v, got %v", test.v, center, test.want, got)
+			}
+		})
+	}
+}
+
+func TestDotAll(t *testing.T) {
+	t.Parallel()
+	vecs := []Vec{V(1, 0), V(0, 1), V(-1, 0), V(3, 4)}
+	dst := make([]float32, len(vecs))
+	DotAll(dst, vecs, V(2, 1))
+	want := []float32{2, 1, -2, 10}
+	for i := range want {
+		if dst[i] != want[i] {
+			t.Errorf("DotAll(..., %v)[%d]: want %v, got %v", V(2, 1), i, want[i], dst[i])
+		}
+	}
+}
+
+func TestBestByDot(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		vecs      []Vec
+		against   Vec
+		wantIndex int
+		wantDot   float32
+	}{
+		{name: "empty", vecs: nil, against: V(1, 0), wantIndex: -1, wantDot: 0},
+		{name: "single", vecs: []Vec{V(-1, 0)}, against: V(1, 0), wantIndex: 0, wantDot: -1},
+		{name: "best aligned", vecs: []Vec{V(1, 0), V(0, 1), V(-1, 0), V(0, -1)}, against: V(0.2, 0.8), wantIndex: 1, wantDot: 0.8},
+		{name: "first on tie", vecs: []Vec{V(0, 1), V(1, 0), V(1, 0)}, against: V(1, 0), wantIndex: 1, wantDot: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotIndex, gotDot := BestByDot(test.vecs, test.against)
+			if gotIndex != test.wantIndex || !EqualApprox(gotDot, test.wantDot) {
+				t.Errorf("BestByDot(%v, %v): want %d, %v, got %d, %v",
+					test.vecs, test.against, test.wantIndex, test.wantDot, gotIndex, gotDot)
+			}
+		})
+	}
+}
+
+func BenchmarkBestByDot(b *testing.B) {
+	vecs := make([]Vec, 1000)
+	for i := range vecs {
+		vecs[i] = VAngle(firefly.Degrees(float32(i)))
+	}
+	against := V(0.6, 0.8)
+
+	for b.Loop() {
+		BestByDot(vecs, against)
+	}
+}
+
+func BenchmarkDotAll(b *testing.B) {
+	vecs := make([]Vec, 1000)
+	for i := range vecs {
+		vecs[i] = VAngle(firefly.Degrees(float32(i)))
+	}
+	dst := make([]float32, len(vecs))
+	against := V(0.6, 0.8)
+
+	for b.Loop() {
+		DotAll(dst, vecs, against)
+	}
+}
+
+func TestVecSlerp(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		from, to Vec
+		weight   float32
+		want     Vec
+	}{
+		{name: "midpoint 90° apart", from: V(1, 0), to: V(0, 1), weight: 0.5, want: V(0.70710678, 0.70710678)},
+		{name: "third of 90° apart", from: V(1, 0), to: V(0, 1), weight: 1. / 3, want: V(0.8660254, 0.5)},
+		{name: "shortest arc", from: V(0, -1), to: V(1, 0), weight: 0.5, want: V(0.70710678, -0.70710678)},
+		{name: "start", from: V(1, 0), to: V(0, 1), weight: 0, want: V(1, 0)},
+		{name: "end", from: V(1, 0), to: V(0, 1), weight: 1, want: V(0, 1)},
+		{name: "lerps length", from: V(2, 0), to: V(0, 4), weight: 0.5, want: V(2.1213203, 2.1213203)},
+		{name: "identical", from: V(3, 4), to: V(3, 4), weight: 0.5, want: V(3, 4)},
+		{name: "opposite", from: V(1, 0), to: V(-1, 0), weight: 0.5, want: V(0, 1)},
+		{name: "zero", from: V(0, 0), to: V(4, 0), weight: 0.5, want: V(2, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.from.Slerp(test.to, test.weight)
+			// tinymath.SinCos has a max error of 0.002
+			if got.DistanceToSquared(test.want) > 0.003*0.003 {
+				t.Errorf("%v.Slerp(%v, %v): want %v, got %v", test.from, test.to, test.weight, test.want, got)
+			}
+		})
+	}
+
+	mid := V(1, 0).Slerp(V(0, 1), 0.5)
+	if length := mid.RadiusSquared(); Abs(length-1) > 0.004 {
+		t.Errorf("V(1, 0).Slerp(V(0, 1), 0.5): want unit length, got squared length %v", length)
+	}
+}
+
+func TestVecInRange(t *testing.T) {
+	t.Parallel()
+	min, max := V(0, 0), V(10, 5)
+	tests := []struct {
+		v             Vec
+		want          bool
+		wantInclusive bool
+	}{
+		{v: V(5, 2), want: true, wantInclusive: true},
+		{v: V(0, 0), want: true, wantInclusive: true},
+		{v: V(10, 2), want: false, wantInclusive: true},
+		{v: V(5, 5), want: false, wantInclusive: true},
+		{v: V(10, 5), want: false, wantInclusive: true},
+		{v: V(-1, 2), want: false, wantInclusive: false},
+		{v: V(5, 6), want: false, wantInclusive: false},
+	}
+
+	for _, test := range tests {
+		if got := test.v.InRange(min, max); got != test.want {
+			t.Errorf("%v.InRange(%v, %v): want %t, got %t", test.v, min, max, test.want, got)
+		}
+		if got := test.v.InRangeInclusive(min, max); got != test.wantInclusive {
+			t.Errorf("%v.InRangeInclusive(%v, %v): want %t, got %t", test.v, min, max, test.wantInclusive, got)
+		}
+	}
+}
+
+func TestVecNormalizeFast(t *testing.T) {
+	t.Parallel()
+	tests := []Vec{V(1, 0), V(0, -3), V(3, 4), V(-0.001, 0.002), V(1000, -2500), V(12345, 6789), V(0.5, 0.5)}
+
+	for _, v := range tests {
+		got := v.NormalizeFast()
+		length := sqrtPrecise(got.RadiusSquared())
+		if Abs(length-1) > 0.002 {
+			t.Errorf("%v.NormalizeFast(): want length 1 within 0.2%%, got %v (length %v)", v, got, length)
+		}
+		if cross := got.Cross(v) / sqrtPrecise(v.RadiusSquared()); Abs(cross) > 0.0001 || got.Dot(v) <= 0 {
+			t.Errorf("%v.NormalizeFast(): want same direction, got %v", v, got)
+		}
+	}
+
+	if got := V(0, 0).NormalizeFast(); !got.IsZero() {
+		t.Errorf("V(0, 0).NormalizeFast(): want {0,0}, got %v", got)
+	}
+}
+
+func BenchmarkVecNormalize(b *testing.B) {
+	vecs := make([]Vec, 1000)
+	for i := range vecs {
+		vecs[i] = V(float32(i+1), float32(-i))
+	}
+
+	for b.Loop() {
+		for i := range vecs {
+			vecs[i] = vecs[i].Normalize().Scale(float32(i + 1))
+		}
+	}
+}
+
+func BenchmarkVecNormalizeFast(b *testing.B) {
+	vecs := make([]Vec, 1000)
+	for i := range vecs {
+		vecs[i] = V(float32(i+1), float32(-i))
+	}
+
+	for b.Loop() {
+		for i := range vecs {
+			vecs[i] = vecs[i].NormalizeFast().Scale(float32(i + 1))
+		}
+	}
+}
+
+func TestVecBounce(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		v           Vec
+		normal      Vec
+		restitution float32
+		friction    float32
+		want        Vec
+	}{
+		{name: "elastic floor", v: V(3, 4), normal: V(0, -1), restitution: 1, friction: 0, want: V(3, -4)},
+		{name: "elastic unnormalized", v: V(3, 4), normal: V(0, -5), restitution: 1, friction: 0, want: V(3, -4)},
+		{name: "elastic diagonal", v: V(1, 0), normal: V(-1, 1), restitution: 1, friction: 0, want: V(0, 1)},
+		{name: "dead stop", v: V(3, 4), normal: V(0, -1), restitution: 0, friction: 0, want: V(3, 0)},
+		{name: "dead stop head-on", v: V(0, 4), normal: V(0, -1), restitution: 0, friction: 0, want: V(0, 0)},
+		{name: "half bounce", v: V(2, 4), normal: V(0, -1), restitution: 0.5, friction: 0.25, want: V(1.5, -2)},
+		{name: "full friction", v: V(3, 4), normal: V(0, -1), restitution: 1, friction: 1, want: V(0, -4)},
+		{name: "zero normal", v: V(3, 4), normal: V(0, 0), restitution: 0.5, friction: 0.5, want: V(3, 4)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.Bounce(test.normal, test.restitution, test.friction)
+			if !got.EqualApprox(test.want) {
+				t.Errorf("%v.Bounce(%v, %v, %v): want %v, got %v",
+					test.v, test.normal, test.restitution, test.friction, test.want, got)
+			}
+			if test.restitution == 1 && test.friction == 0 {
+				if reflected := test.v.Reflect(test.normal); !reflected.EqualApprox(got) {
+					t.Errorf("%v.Reflect(%v): want same as Bounce %v, got %v", test.v, test.normal, got, reflected)
+				}
+			}
+		})
+	}
+}
+
+func TestVecLocalTo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Vec
+		forward   Vec
+		wantAlong float32
+		wantPerp  float32
+	}{
+		{name: "45° right of forward", v: V(3, 3), forward: V(1, 0), wantAlong: 3, wantPerp: 3},
+		{name: "45° left of forward", v: V(3, -3), forward: V(2, 0), wantAlong: 3, wantPerp: -3},
+		{name: "45° velocity on diagonal axis", v: V(0, 4), forward: V(1, 1), wantAlong: 2.828427, wantPerp: 2.828427},
+		{name: "backwards", v: V(-5, 0), forward: V(1, 0), wantAlong: -5, wantPerp: 0},
+		{name: "zero forward", v: V(3, 4), forward: V(0, 0), wantAlong: 0, wantPerp: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			along, perp := test.v.LocalTo(test.forward)
+			if !EqualApprox(along, test.wantAlong) || !EqualApprox(perp, test.wantPerp) {
+				t.Errorf("%v.LocalTo(%v): want %v, %v, got %v, %v",
+					test.v, test.forward, test.wantAlong, test.wantPerp, along, perp)
+			}
+			if test.forward.IsZero() {
+				return
+			}
+			// the components should rebuild the vector
+			dir := test.forward.Scale(1 / sqrtPrecise(test.forward.RadiusSquared()))
+			rebuilt := dir.Scale(along).Add(dir.Rotate90().Scale(perp))
+			if !rebuilt.EqualApprox(test.v) {
+				t.Errorf("%v.LocalTo(%v): components rebuild to %v", test.v, test.forward, rebuilt)
+			}
+		})
+	}
+}
+
+func TestVecDamp(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		v           Vec
+		rate, delta float32
+		want        Vec
+	}{
+		{name: "shortens", v: V(16, 0), rate: 8, delta: 0.5, want: V(12, 0)},
+		{name: "negative", v: V(0, -16), rate: 8, delta: 0.5, want: V(0, -12)},
+		{name: "stops at zero", v: V(-1, 1), rate: 8, delta: 0.5, want: V(0, 0)},
+		{name: "already zero", v: V(0, 0), rate: 8, delta: 0.5, want: V(0, 0)},
+		{name: "no time passed", v: V(3, 4), rate: 8, delta: 0, want: V(3, 4)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.v.Damp(test.rate, test.delta)
+			if !got.EqualApprox(test.want) {
+				t.Errorf("%v.Damp(%v, %v): want %v, got %v", test.v, test.rate, test.delta, test.want, got)
+			}
+		})
+	}
+
+	// keeps the direction while shortening
+	v := V(30, 40)
+	got := v.Damp(10, 1)
+	if !got.SameDirectionApprox(v) || got.RadiusSquared() >= v.RadiusSquared() {
+		t.Errorf("%v.Damp(10, 1) = %v, want shorter in the same direction", v, got)
+	}
+}
+
+func TestVecMoveTowardsEased(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		from, to   Vec
+		maxDelta   float32
+		slowRadius float32
+		want       Vec
+	}{
+		{name: "full speed far away", from: V(0, 0), to: V(64, 0), maxDelta: 4, slowRadius: 16, want: V(4, 0)},
+		{name: "full speed at slow radius", from: V(0, 0), to: V(0, 16), maxDelta: 4, slowRadius: 16, want: V(0, 4)},
+		{name: "reduced inside slow radius", from: V(0, 0), to: V(4, 0), maxDelta: 4, slowRadius: 16, want: V(1, 0)},
+		{name: "reduced closer to target", from: V(0, 0), to: V(0, -1), maxDelta: 4, slowRadius: 16, want: V(0, -0.25)},
+		{name: "no slow radius", from: V(0, 0), to: V(4, 0), maxDelta: 2, slowRadius: 0, want: V(2, 0)},
+		{name: "does not overshoot", from: V(0, 0), to: V(1, 0), maxDelta: 4, slowRadius: 0.5, want: V(1, 0)},
+		{name: "at target", from: V(3, 3), to: V(3, 3), maxDelta: 4, slowRadius: 16, want: V(3, 3)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.from.MoveTowardsEased(test.to, test.maxDelta, test.slowRadius)
+			if !got.EqualApprox(test.want) {
+				t.Errorf("%v.MoveTowardsEased(%v, %v, %v): want %v, got %v",
+					test.from, test.to, test.maxDelta, test.slowRadius, test.want, got)
+			}
+		})
+	}
+}
+
+func TestVecSamePixel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		a, b Vec
+		want bool
+	}{
+		{name: "equal", a: V(3, 4), b: V(3, 4), want: true},
+		{name: "within same pixel", a: V(3.1, 4.9), b: V(3.8, 4.2), want: true},
+		{name: "straddling X boundary", a: V(3.99, 4.5), b: V(4.01, 4.5), want: false},
+		{name: "straddling Y boundary", a: V(3.5, 4.99), b: V(3.5, 5), want: false},
+		{name: "truncated around zero", a: V(-0.5, 0), b: V(0.5, 0), want: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.a.SamePixel(test.b); got != test.want {
+				t.Errorf("%v.SamePixel(%v): want %t, got %t", test.a, test.b, test.want, got)
+			}
+			if got := test.b.SamePixel(test.a); got != test.want {
+				t.Errorf("%v.SamePixel(%v): want %t, got %t", test.b, test.a, test.want, got)
+			}
+		})
+	}
+}
+
+func TestMoveTowardsEach(t *testing.T) {
+	t.Parallel()
+	positions := []Vec{V(0, 0), V(10, 10), V(5, 5), V(0, 0)}
+	targets := []Vec{V(16, 0), V(10, -6), V(5, 6), V(0, 0)}
+	MoveTowardsEach(positions, targets, 4)
+	want := []Vec{V(4, 0), V(10, 6), V(5, 6), V(0, 0)}
+	for i := range want {
+		if !positions[i].EqualApprox(want[i]) {
+			t.Errorf("positions[%d]: want %v, got %v", i, want[i], positions[i])
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MoveTowardsEach with mismatched lengths: want panic")
+		}
+	}()
+	MoveTowardsEach(positions, targets[:2], 4)
+}
+
+func BenchmarkMoveTowardsEach(b *testing.B) {
+	positions := make([]Vec, 1000)
+	targets := make([]Vec, 1000)
+	for i := range targets {
+		targets[i] = V(float32(i), float32(1000-i))
+	}
+
+	for b.Loop() {
+		MoveTowardsEach(positions, targets, 0.5)
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import "github.com/firefly-zero/firefly-go/firefly"
+
+// Utility type for dealing with integer-based positions,
+// such as grid or tile coordinates.
+//
+// While [firefly.Point] is meant for pixels on the screen, this type is meant
+// for game logic coordinates, keeping grid logic exact and separate from
+// pixel space.
+type Veci struct {
+	X int
+	Y int
+}
+
+// Shortcut for creating a [Veci].
+func Vi(x, y int) Veci {
+	return Veci{X: x, Y: y}
+}
+
+// Converts a [firefly.Point] to a [Veci]
+func ViPoint(point firefly.Point) Veci {
+	return Veci{X: point.X, Y: point.Y}
+}
+
+// Convert a [Veci] to a [firefly.Point].
+func (v Veci) ToPoint() firefly.Point {
+	return firefly.Point{X: v.X, Y: v.Y}
+}
+
+// Convert a [Veci] to a [Vec].
+func (v Veci) ToVec() Vec {
+	return Vec{X: float32(v.X), Y: float32(v.Y)}
+}
+
+// Adds a position.
+func (v Veci) Add(rhs Veci) Veci {
+	return Veci{X: v.X + rhs.X, Y: v.Y + rhs.Y}
+}
+
+// Subtract a position.
+func (v Veci) Sub(rhs Veci) Veci {
+	return Veci{X: v.X - rhs.X, Y: v.Y - rhs.Y}
+}
+
+// Get a position where both the X and Y value are individually multiplied by the scalar factor.
+func (v Veci) Scale(factor int) Veci {
+	return Veci{X: v.X * factor, Y: v.Y * factor}
+}
+
+// Get the Manhattan distance to another position,
+// i.e the number of steps when only moving orthogonally.
+func (v Veci) ManhattanTo(to Veci) int {
+	return Abs(to.X-v.X) + Abs(to.Y-v.Y)
+}
+
+// Get the Chebyshev distance to another position,
+// i.e the number of steps when also moving diagonally.
+func (v Veci) ChebyshevTo(to Veci) int {
+	return max(Abs(to.X-v.X), Abs(to.Y-v.Y))
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffmath
+
+import (
+	"testing"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+)
+
+func TestVeci(t *testing.T) {
+	t.Parallel()
+	a := Vi(3, -4)
+	b := Vi(-2, 5)
+
+	if got, want := a.Add(b), Vi(1, 1); got != want {
+		t.Errorf("%v.Add(%v): want %v, got %v", a, b, want, got)
+	}
+	if got, want := a.Sub(b), Vi(5, -9); got != want {
+		t.Errorf("%v.Sub(%v): want %v, got %v", a, b, want, got)
+	}
+	if got, want := a.Scale(-2), Vi(-6, 8); got != want {
+		t.Errorf("%v.Scale(-2): want %v, got %v", a, want, got)
+	}
+	if got, want := a.ManhattanTo(b), 14; got != want {
+		t.Errorf("%v.ManhattanTo(%v): want %v, got %v", a, b, want, got)
+	}
+	if got, want := b.ManhattanTo(a), 14; got != want {
+		t.Errorf("%v.ManhattanTo(%v): want %v, got %v", b, a, want, got)
+	}
+	if got, want := a.ChebyshevTo(b), 9; got != want {
+		t.Errorf("%v.ChebyshevTo(%v): want %v, got %v", a, b, want, got)
+	}
+	if got, want := a.ToVec(), V(3, -4); got != want {
+		t.Errorf("%v.ToVec(): want %v, got %v", a, want, got)
+	}
+	if got, want := a.ToPoint(), firefly.P(3, -4); got != want {
+		t.Errorf("%v.ToPoint(): want %v, got %v", a, want, got)
+	}
+	if got, want := ViPoint(firefly.P(-7, 2)), Vi(-7, 2); got != want {
+		t.Errorf("ViPoint(%v): want %v, got %v", firefly.P(-7, 2), want, got)
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+// Shuffle-bag, which draws items in a pseudo-random order without
+// replacement, and reshuffles once all items have been drawn.
+//
+// This gives a fairer distribution than pure randomness, which can feel
+// streaky, such as for picking tetromino pieces.
+// Every item is drawn exactly once before any item is repeated.
+type Bag[T any] struct {
+	items []T
+	next  int
+	rand  Intner
+}
+
+// Creates a new [Bag] with a copy of the given items.
+//
+// To add an item multiple times per round, include it multiple times.
+//
+// Uses the default [Rand].
+func NewBag[T any](items []T) Bag[T] {
+	return NewBagWith(globalRand, items)
+}
+
+// Creates a new [Bag] with a copy of the given items,
+// which uses the given random number generator for shuffling.
+//
+// Useful for reproducible draws that don't touch the global state,
+// such as using a [rand.Rand] created from a match seed.
+func NewBagWith[T any](r Intner, items []T) Bag[T] {
+	return Bag[T]{
+		items: append([]T(nil), items...),
+		// start out exhausted, to shuffle on the first draw
+		next: len(items),
+		rand: r,
+	}
+}
+
+// Number of items in the bag per round.
+func (b *Bag[T]) Len() int {
+	return len(b.items)
+}
+
+// Draws the next item, reshuffling the bag first if all items
+// have been drawn.
+//
+// It panics if the bag has no items.
+func (b *Bag[T]) Next() T {
+	if len(b.items) == 0 {
+		panic("invalid argument to Bag.Next")
+	}
+	if b.next >= len(b.items) {
+		ShuffleSliceWith(b.rand, b.items)
+		b.next = 0
+	}
+	item := b.items[b.next]
+	b.next++
+	return item
+}
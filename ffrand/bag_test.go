@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBagDrawsAllBeforeRepeating(t *testing.T) {
+	t.Parallel()
+	items := []string{"I", "O", "T", "S", "Z", "J", "L"}
+	bag := NewBagWith(rand.New(rand.NewSource(1)), items)
+
+	for round := range 5 {
+		var drawn []string
+		for range bag.Len() {
+			drawn = append(drawn, bag.Next())
+		}
+		slices.Sort(drawn)
+		want := slices.Sorted(slices.Values(items))
+		if !slices.Equal(drawn, want) {
+			t.Errorf("round %d: want each item once %v, got %v", round, want, drawn)
+		}
+	}
+
+	// items are copied, so the input slice is untouched
+	if want := []string{"I", "O", "T", "S", "Z", "J", "L"}; !slices.Equal(items, want) {
+		t.Errorf("input items were modified: want %v, got %v", want, items)
+	}
+}
+
+func TestBagReproducible(t *testing.T) {
+	t.Parallel()
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	draw := func(seed int64) []int {
+		bag := NewBagWith(rand.New(rand.NewSource(seed)), items)
+		var drawn []int
+		for range 3 * len(items) {
+			drawn = append(drawn, bag.Next())
+		}
+		return drawn
+	}
+
+	first := draw(42)
+	if second := draw(42); !slices.Equal(first, second) {
+		t.Errorf("same seed gave different draws:\n%v\n%v", first, second)
+	}
+	if other := draw(7); slices.Equal(first, other) {
+		t.Errorf("different seeds gave the same draws: %v", first)
+	}
+}
+
+func TestBagEmptyPanics(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Next() on empty Bag: want panic")
+		}
+	}()
+	bag := NewBagWith[int](rand.New(rand.NewSource(1)), nil)
+	bag.Next()
+}
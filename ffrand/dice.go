@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Pseudo-random sum of "count" dice rolls with "sides" sides each, plus "modifier".
+//
+// For example, RollDice(3, 6, 2) rolls "3d6+2", which is in the range [5, 20].
+// Each die is in the range [1, sides], so the result is in the range
+// [count+modifier, count*sides+modifier].
+//
+// It panics if count < 0 or sides < 1.
+func (r Rand) RollDice(count, sides, modifier int) int {
+	return RollDiceWith(r, count, sides, modifier)
+}
+
+// Pseudo-random sum of "count" dice rolls with "sides" sides each, plus "modifier",
+// using the given random number generator.
+//
+// Useful for reproducible rolls that don't touch the global state,
+// such as using a [rand.Rand] created from a match seed.
+//
+// See [Rand.RollDice] for more details.
+//
+// It panics if count < 0 or sides < 1.
+func RollDiceWith(r Intner, count, sides, modifier int) int {
+	if count < 0 || sides < 1 {
+		panic("invalid argument to RollDice")
+	}
+	sum := modifier
+	for range count {
+		sum += 1 + r.Intn(sides)
+	}
+	return sum
+}
+
+// Pseudo-random sum of "count" dice rolls with "sides" sides each, plus "modifier".
+//
+// It panics if count < 0 or sides < 1.
+//
+// Uses the default [Rand].
+func RollDice(count, sides, modifier int) int { return globalRand.RollDice(count, sides, modifier) }
+
+// Parses dice notation such as "2d8+1" and returns the pseudo-random result.
+//
+// See [ParseDice] for the supported notation.
+//
+// Uses the default [Rand].
+func ParseAndRoll(notation string) (int, error) {
+	count, sides, modifier, err := ParseDice(notation)
+	if err != nil {
+		return 0, err
+	}
+	return RollDice(count, sides, modifier), nil
+}
+
+// Parses dice notation, as used in tabletop games, into its parts
+// to be used with [RollDice].
+//
+// The notation is on the format "NdS+M", where:
+//
+//   - N is the number of dice. Optional, defaults to 1.
+//   - S is the number of sides on each die.
+//   - M is the modifier, prefixed with either + or -. Optional, defaults to 0.
+//
+// Examples: "3d6", "d20", "2d8+1", "1d4-1".
+func ParseDice(notation string) (count, sides, modifier int, err error) {
+	countStr, rest, ok := strings.Cut(notation, "d")
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("invalid dice notation %q: missing 'd'", notation)
+	}
+	count = 1
+	if countStr != "" {
+		count, err = parseDiceInt(countStr)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid dice notation %q: dice count: %w", notation, err)
+		}
+	}
+	sidesStr := rest
+	if i := strings.IndexAny(rest, "+-"); i != -1 {
+		sidesStr = rest[:i]
+		modifier, err = parseDiceInt(rest[i+1:])
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid dice notation %q: modifier: %w", notation, err)
+		}
+		if rest[i] == '-' {
+			modifier = -modifier
+		}
+	}
+	sides, err = parseDiceInt(sidesStr)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid dice notation %q: sides: %w", notation, err)
+	}
+	if sides < 1 {
+		return 0, 0, 0, fmt.Errorf("invalid dice notation %q: must have at least 1 side", notation)
+	}
+	return count, sides, modifier, nil
+}
+
+// Parses a non-negative integer without any sign.
+func parseDiceInt(s string) (int, error) {
+	if s == "" || s[0] == '+' || s[0] == '-' {
+		return 0, fmt.Errorf("expected a number, got %q", s)
+	}
+	return strconv.Atoi(s)
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRollDiceWith(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		count, sides, modifier int
+	}{
+		{count: 3, sides: 6, modifier: 2},
+		{count: 1, sides: 20, modifier: 0},
+		{count: 2, sides: 8, modifier: -5},
+		{count: 4, sides: 1, modifier: 0},
+		{count: 0, sides: 6, modifier: 3},
+	}
+
+	for _, test := range tests {
+		r := rand.New(rand.NewSource(1))
+		wantMin := test.count + test.modifier
+		wantMax := test.count*test.sides + test.modifier
+		gotMin, gotMax := wantMax, wantMin
+		for range 1000 {
+			got := RollDiceWith(r, test.count, test.sides, test.modifier)
+			if got < wantMin || got > wantMax {
+				t.Fatalf("RollDiceWith(r, %d, %d, %d): want in range [%d, %d], got %d",
+					test.count, test.sides, test.modifier, wantMin, wantMax, got)
+			}
+			gotMin, gotMax = min(gotMin, got), max(gotMax, got)
+		}
+		// with this many rolls, both extremes should show up
+		if gotMin != wantMin || gotMax != wantMax {
+			t.Errorf("RollDiceWith(r, %d, %d, %d): want range [%d, %d] covered, got [%d, %d]",
+				test.count, test.sides, test.modifier, wantMin, wantMax, gotMin, gotMax)
+		}
+	}
+}
+
+func TestRollDiceWithPanics(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		count       int
+		sides       int
+		shouldPanic bool
+	}{
+		{name: "zero dice", count: 0, sides: 6, shouldPanic: false},
+		{name: "negative count", count: -1, sides: 6, shouldPanic: true},
+		{name: "zero sides", count: 1, sides: 0, shouldPanic: true},
+		{name: "negative sides", count: 1, sides: -6, shouldPanic: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if got := recover() != nil; got != test.shouldPanic {
+					t.Errorf("RollDiceWith(r, %d, %d, 0): want panic=%t, got panic=%t",
+						test.count, test.sides, test.shouldPanic, got)
+				}
+			}()
+			RollDiceWith(rand.New(rand.NewSource(1)), test.count, test.sides, 0)
+		})
+	}
+}
+
+func TestParseDice(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		notation     string
+		wantCount    int
+		wantSides    int
+		wantModifier int
+		wantErr      bool
+	}{
+		{notation: "3d6", wantCount: 3, wantSides: 6},
+		{notation: "d20", wantCount: 1, wantSides: 20},
+		{notation: "2d8+1", wantCount: 2, wantSides: 8, wantModifier: 1},
+		{notation: "1d4-1", wantCount: 1, wantSides: 4, wantModifier: -1},
+		{notation: "0d6", wantCount: 0, wantSides: 6},
+		{notation: "", wantErr: true},
+		{notation: "d", wantErr: true},
+		{notation: "3d", wantErr: true},
+		{notation: "3x6", wantErr: true},
+		{notation: "-1d6", wantErr: true},
+		{notation: "ad6", wantErr: true},
+		{notation: "2d0", wantErr: true},
+		{notation: "2d6+", wantErr: true},
+		{notation: "2d6+-1", wantErr: true},
+		{notation: "2d6+1+1", wantErr: true},
+	}
+
+	for _, test := range tests {
+		count, sides, modifier, err := ParseDice(test.notation)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseDice(%q): want error, got %d, %d, %d", test.notation, count, sides, modifier)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDice(%q): unexpected error: %v", test.notation, err)
+			continue
+		}
+		if count != test.wantCount || sides != test.wantSides || modifier != test.wantModifier {
+			t.Errorf("ParseDice(%q)\nwant: %d, %d, %d\ngot:  %d, %d, %d", test.notation,
+				test.wantCount, test.wantSides, test.wantModifier, count, sides, modifier)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import "github.com/applejag/firefly-go-math/ffmath"
+
+// Pseudo-random index sampled according to the given probabilities,
+// such as for loot and event tables.
+//
+// The probabilities must all be non-negative and sum to approximately 1
+// (see [ffmath.EqualApprox]). Otherwise false is returned, which helps
+// catching data-entry errors in the tables.
+// Indexes with a probability of 0 are never returned.
+func (r Rand) Discrete(probabilities []float32) (int, bool) {
+	if !validProbabilities(probabilities) {
+		return 0, false
+	}
+	return discreteIndex(probabilities, r.Float32()), true
+}
+
+// Pseudo-random index sampled according to the given probabilities.
+//
+// Returns false if the probabilities are negative or don't sum to approximately 1.
+//
+// Uses the default [Rand].
+func Discrete(probabilities []float32) (int, bool) { return globalRand.Discrete(probabilities) }
+
+func validProbabilities(probabilities []float32) bool {
+	var sum float32
+	for _, p := range probabilities {
+		if p < 0 {
+			return false
+		}
+		sum += p
+	}
+	return ffmath.EqualApprox(sum, 1)
+}
+
+// Picks the index of the probability that the uniform value "u" in the
+// half-open interval [0, 1) falls into.
+func discreteIndex(probabilities []float32, u float32) int {
+	last := 0
+	var cumulative float32
+	for i, p := range probabilities {
+		if p == 0 {
+			continue
+		}
+		cumulative += p
+		if u < cumulative {
+			return i
+		}
+		last = i
+	}
+	// only reached due to rounding errors when the sum is slightly below 1
+	return last
+}
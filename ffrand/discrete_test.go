@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDiscreteInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		probabilities []float32
+	}{
+		{name: "empty", probabilities: nil},
+		{name: "sum below 1", probabilities: []float32{0.2, 0.3}},
+		{name: "sum above 1", probabilities: []float32{0.5, 0.6}},
+		{name: "weights instead of probabilities", probabilities: []float32{1, 2, 3}},
+		{name: "negative", probabilities: []float32{1.5, -0.5}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if validProbabilities(test.probabilities) {
+				t.Errorf("validProbabilities(%v): want false, got true", test.probabilities)
+			}
+		})
+	}
+}
+
+func TestDiscreteDistribution(t *testing.T) {
+	t.Parallel()
+	probabilities := []float32{0.5, 0, 0.2, 0.3}
+	if !validProbabilities(probabilities) {
+		t.Fatalf("validProbabilities(%v): want true, got false", probabilities)
+	}
+
+	const samples = 100000
+	rng := rand.New(rand.NewSource(1))
+	counts := make([]int, len(probabilities))
+	for range samples {
+		counts[discreteIndex(probabilities, rng.Float32())]++
+	}
+
+	for i, p := range probabilities {
+		got := float32(counts[i]) / samples
+		if got < p-0.01 || got > p+0.01 {
+			t.Errorf("index %d: want frequency %v, got %v", i, p, got)
+		}
+	}
+	if counts[1] != 0 {
+		t.Errorf("index 1 has probability 0, but was picked %d times", counts[1])
+	}
+}
+
+func TestDiscreteIndexEdges(t *testing.T) {
+	t.Parallel()
+	probabilities := []float32{0.25, 0.75, 0}
+	tests := []struct {
+		u    float32
+		want int
+	}{
+		{u: 0, want: 0},
+		{u: 0.2499, want: 0},
+		{u: 0.25, want: 1},
+		{u: 0.9999999, want: 1},
+		// rounding errors should never pick the zero probability
+		{u: 1, want: 1},
+	}
+
+	for _, test := range tests {
+		if got := discreteIndex(probabilities, test.u); got != test.want {
+			t.Errorf("discreteIndex(%v, %v): want %d, got %d", probabilities, test.u, test.want, got)
+		}
+	}
+}
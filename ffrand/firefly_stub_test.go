@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"sync"
+	_ "unsafe" // for go:linkname
+)
+
+var (
+	stubRandomMu    sync.Mutex
+	stubRandomState uint32 = 2463534242
+)
+
+// Provides the body of the [firefly.GetRandom] host import, which only
+// exists when running inside the Firefly Zero runtime, so that the
+// default [Rand] can be used in tests.
+//
+// Uses a xorshift generator, so the values are not reproducible across
+// tests running in parallel. Use a seeded [rand.Rand] for that.
+//
+//go:linkname getRandom github.com/firefly-zero/firefly-go/firefly.getRandom
+func getRandom() uint32 {
+	stubRandomMu.Lock()
+	defer stubRandomMu.Unlock()
+	x := stubRandomState
+	x ^= x << 13
+	x ^= x >> 17
+	x ^= x << 5
+	stubRandomState = x
+	return x
+}
@@ -28,8 +28,14 @@ var globalRand = Rand{}
 // in an effort to call [firefly.GetRandom] as few times as possible.
 type Rand struct{}
 
-// ensure it implements the interface
-var _ rand.Source = Rand{}
+// ensure it implements the interfaces
+var (
+	_ rand.Source = Rand{}
+	_ Intner      = Rand{}
+	_ Intner      = (*rand.Rand)(nil)
+	_ Float32er   = Rand{}
+	_ Float32er   = (*rand.Rand)(nil)
+)
 
 // Non-negative pseudo-random 63-bit integer as an int64.
 //
@@ -142,6 +148,47 @@ func (r Rand) Float32Range(min, max float32) float32 {
 	return min + r.Float32()*(max-min)
 }
 
+// Normally distributed float64 in the range [-math.MaxFloat64, +math.MaxFloat64]
+// with standard normal distribution (mean = 0, stddev = 1).
+//
+// To produce a different normal distribution, callers can adjust the output using:
+//
+//	sample = NormFloat64() * desiredStdDev + desiredMean
+//
+// Unlike [rand.Rand.NormFloat64], this uses the Box-Muller transform,
+// which is simpler but slower than the ziggurat algorithm used by the stdlib,
+// in favor of not needing the large lookup tables.
+func (r Rand) NormFloat64() float64 {
+	return boxMuller(r.Float64(), r.Float64())
+}
+
+// Transforms two uniform values in the half-open interval [0, 1)
+// into a standard normally distributed value, using the Box-Muller transform.
+func boxMuller(u1, u2 float64) float64 {
+	// 1-u1 is in the range (0, 1], to avoid log(0)
+	return math.Sqrt(-2*math.Log(1-u1)) * math.Cos(2*math.Pi*u2)
+}
+
+// Pseudo-randomizes the order of elements.
+//
+// n is the number of elements.
+// It panics if n < 0.
+// The "swap" callback is expected to swap the elements with indexes i and j.
+//
+// Implements the Fisher-Yates shuffle algorithm.
+//
+// This can't be seeded independently of the global [firefly.GetRandom] state,
+// so use [ShuffleSliceWith] with a seeded [rand.Rand] for deterministic shuffles.
+func (r Rand) Shuffle(n int, swap func(i, j int)) {
+	if n <= 0 {
+		panic("invalid argument to Shuffle")
+	}
+	for i := n - 1; i > 0; i = i - 1 {
+		j := r.Intn(i + 1)
+		swap(i, j)
+	}
+}
+
 // Pseudo-random 63-bit integer as an int64.
 //
 // Uses the default [Rand].
@@ -198,6 +245,12 @@ func Float64() float64 { return globalRand.Float64() }
 // Uses the default [Rand].
 func Float32() float32 { return globalRand.Float32() }
 
+// Normally distributed float64 with standard normal distribution
+// (mean = 0, stddev = 1).
+//
+// Uses the default [Rand].
+func NormFloat64() float64 { return globalRand.NormFloat64() }
+
 // Pseudo-random intger in the half-open interval [min,max)
 //
 // It panics if min==max.
@@ -224,24 +277,41 @@ func Float32Range(min, max float32) float32 { return globalRand.Float32Range(min
 // Implements the Fisher-Yates shuffle algorithm.
 //
 // Uses the default [Rand].
-func Shuffle(n int, swap func(i, j int)) {
-	if n <= 0 {
-		panic("invalid argument to Shuffle")
-	}
-	for i := n - 1; i > 0; i = i - 1 {
-		j := Intn(i + 1)
-		swap(i, j)
-	}
-}
+func Shuffle(n int, swap func(i, j int)) { globalRand.Shuffle(n, swap) }
 
 // Pseudo-randomizes a generic slice.
 //
 // Implements the Fisher-Yates shuffle algorithm.
 //
 // Uses the default [Rand].
-func ShuffleSlice[E any, S ~[]E](slice S) {
+func ShuffleSlice[E any, S ~[]E](slice S) { ShuffleSliceWith(globalRand, slice) }
+
+// Random number generator used by functions like [ShuffleSliceWith].
+//
+// Implemented by both [Rand] and [rand.Rand].
+type Intner interface {
+	// Non-negative pseudo-random number in the half-open interval [0,n).
+	Intn(n int) int
+}
+
+// Random number generator used by types like [Shake].
+//
+// Implemented by both [Rand] and [rand.Rand].
+type Float32er interface {
+	// Pseudo-random number in the half-open interval [0.0,1.0).
+	Float32() float32
+}
+
+// Pseudo-randomizes a generic slice using the given random number generator.
+//
+// Useful for reproducible shuffles that don't touch the global state,
+// such as shuffling a deck of cards in a networked match using a [rand.Rand]
+// created with [rand.New] and [rand.NewSource] from the match seed.
+//
+// Implements the Fisher-Yates shuffle algorithm.
+func ShuffleSliceWith[E any, S ~[]E](r Intner, slice S) {
 	for i := len(slice) - 1; i > 0; i = i - 1 {
-		j := Intn(i + 1)
+		j := r.Intn(i + 1)
 		slice[i], slice[j] = slice[j], slice[i]
 	}
 }
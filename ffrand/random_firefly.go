@@ -61,3 +61,34 @@ func Anglen(n firefly.Angle) firefly.Angle {
 func AngleRange(min, max firefly.Angle) firefly.Angle {
 	return min.Add(firefly.Radians(Float32() * ffmath.AngleDifference(min, max).Radians()))
 }
+
+// Pseudo-random angle that is normally distributed around "center",
+// with the standard deviation "stddev".
+//
+// Useful for spread patterns that cluster towards the aim direction,
+// such as muzzle flashes and shrapnel.
+//
+// The extreme tails of the distribution are clamped to 3 standard deviations
+// (covering 99.7% of samples), and never deviates more than 180° from
+// "center" to avoid wrapping around.
+func (r Rand) AngleSpreadGaussian(center firefly.Angle, stddev firefly.Angle) firefly.Angle {
+	return gaussianSpread(center, stddev, r.NormFloat64())
+}
+
+// Pseudo-random angle that is normally distributed around "center",
+// with the standard deviation "stddev".
+//
+// See [Rand.AngleSpreadGaussian] for more details.
+//
+// Uses the default [Rand].
+func AngleSpreadGaussian(center firefly.Angle, stddev firefly.Angle) firefly.Angle {
+	return globalRand.AngleSpreadGaussian(center, stddev)
+}
+
+// Offsets "center" by the standard normally distributed value "normal"
+// scaled by "stddev", clamped to min(3 stddev, 180°).
+func gaussianSpread(center firefly.Angle, stddev firefly.Angle, normal float64) firefly.Angle {
+	limit := min(3*tinymath.Abs(stddev.Radians()), tinymath.Pi)
+	offset := ffmath.Clamp(float32(normal)*stddev.Radians(), -limit, limit)
+	return center.Add(firefly.Radians(offset))
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/firefly-zero/firefly-go/firefly"
+)
+
+func TestShuffleSliceWith(t *testing.T) {
+	t.Parallel()
+	deck := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	shuffle := func(seed int64) []int {
+		cards := slices.Clone(deck)
+		ShuffleSliceWith(rand.New(rand.NewSource(seed)), cards)
+		return cards
+	}
+
+	first := shuffle(42)
+	if second := shuffle(42); !slices.Equal(first, second) {
+		t.Errorf("same seed gave different orders:\n%v\n%v", first, second)
+	}
+	if other := shuffle(7); slices.Equal(first, other) {
+		t.Errorf("different seeds gave the same order: %v", first)
+	}
+	if sorted := slices.Sorted(slices.Values(first)); !slices.Equal(sorted, deck) {
+		t.Errorf("shuffled slice is not a permutation of the input: want %v, got %v", deck, first)
+	}
+}
+
+// Mean and standard deviation of the samples.
+func meanStddev(samples []float64) (mean, stddev float64) {
+	for _, x := range samples {
+		mean += x
+	}
+	mean /= float64(len(samples))
+	for _, x := range samples {
+		stddev += (x - mean) * (x - mean)
+	}
+	return mean, math.Sqrt(stddev / float64(len(samples)))
+}
+
+func TestBoxMuller(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(42))
+	samples := make([]float64, 100_000)
+	for i := range samples {
+		samples[i] = boxMuller(src.Float64(), src.Float64())
+	}
+
+	mean, stddev := meanStddev(samples)
+	if math.Abs(mean) > 0.02 {
+		t.Errorf("mean: want ~0, got %v", mean)
+	}
+	if math.Abs(stddev-1) > 0.02 {
+		t.Errorf("stddev: want ~1, got %v", stddev)
+	}
+
+	if got := boxMuller(0, 0); math.IsInf(got, 0) || math.IsNaN(got) {
+		t.Errorf("boxMuller(0, 0): want finite, got %v", got)
+	}
+}
+
+func TestGaussianSpread(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		stddevDeg   float32
+		wantLimit   float64
+		checkStddev bool
+	}{
+		{name: "narrow", stddevDeg: 5, wantLimit: 15 * math.Pi / 180, checkStddev: true},
+		{name: "wide", stddevDeg: 30, wantLimit: 90 * math.Pi / 180, checkStddev: true},
+		{name: "clamped to half turn", stddevDeg: 120, wantLimit: math.Pi},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			src := rand.New(rand.NewSource(42))
+			center := firefly.Degrees(90)
+			stddev := firefly.Degrees(test.stddevDeg)
+			offsets := make([]float64, 100_000)
+			for i := range offsets {
+				angle := gaussianSpread(center, stddev, boxMuller(src.Float64(), src.Float64()))
+				offsets[i] = float64(angle.Radians() - center.Radians())
+				if math.Abs(offsets[i]) > test.wantLimit+1e-5 {
+					t.Fatalf("offset %v rad exceeds the limit %v rad", offsets[i], test.wantLimit)
+				}
+			}
+
+			mean, got := meanStddev(offsets)
+			want := float64(stddev.Radians())
+			if math.Abs(mean) > 0.02*want {
+				t.Errorf("mean offset: want ~0, got %v rad", mean)
+			}
+			// clamping the tails at 3 stddev only shrinks the stddev by ~1.5%
+			if test.checkStddev && math.Abs(got-want) > 0.03*want {
+				t.Errorf("stddev: want ~%v rad, got %v rad", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import "hash/fnv"
+
+// Creates a stable seed from a string, such as a player-entered world seed,
+// to be used with [Rand.Seed].
+//
+// Uses the 64-bit FNV-1a hash, so the same string always results in the
+// same seed across devices and versions.
+//
+// Note that [Rand.Seed] truncates the seed down into a uint32,
+// so only the lower 32 bits are used when seeding Firefly Zero's random number generator.
+func SeedFromString(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestSeedFromString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		s    string
+		want uint64
+	}{
+		// well-known FNV-1a 64-bit test vectors
+		{s: "", want: 0xcbf29ce484222325},
+		{s: "a", want: 0xaf63dc4c8601ec8c},
+		{s: "foobar", want: 0x85944171f73967e8},
+	}
+
+	for _, test := range tests {
+		if got := SeedFromString(test.s); got != test.want {
+			t.Errorf("SeedFromString(%q): want %#x, got %#x", test.s, test.want, got)
+		}
+		if again := SeedFromString(test.s); again != test.want {
+			t.Errorf("SeedFromString(%q) second call: want %#x, got %#x", test.s, test.want, again)
+		}
+	}
+}
+
+func TestSeedFromStringCollisions(t *testing.T) {
+	t.Parallel()
+	seen := make(map[uint32]string)
+	for i := range 10000 {
+		s := "world-" + strconv.Itoa(i)
+		// only the lower 32 bits are used by Rand.Seed
+		seed := uint32(SeedFromString(s))
+		if prev, ok := seen[seed]; ok {
+			t.Fatalf("SeedFromString(%q) collides with SeedFromString(%q): %#x", s, prev, seed)
+		}
+		seen[seed] = s
+	}
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import "github.com/applejag/firefly-go-math/ffmath"
+
+// Screen shake using the "trauma" model.
+//
+// Trauma is a value in the range [0, 1] that is added to on impacts
+// and decays linearly to zero over time.
+// The shake offset scales with trauma², which makes small impacts subtle
+// while big impacts are felt strongly.
+//
+// Create it with [NewShake] or [NewShakeWith], or set the MaxOffset and
+// DecayRate fields directly, which then uses the default [Rand].
+// The zero value never shakes.
+type Shake struct {
+	// Maximum offset on each axis, reached at full trauma.
+	MaxOffset float32
+	// Amount of trauma that decays per unit of time,
+	// e.g 0.5 makes full trauma decay to zero in 2 seconds if delta is in seconds.
+	DecayRate float32
+
+	trauma float32
+	rand   Float32er
+}
+
+// Creates a new [Shake] that shakes at most "maxOffset" on each axis,
+// where full trauma decays to zero in 1/decayRate units of time.
+//
+// Uses the default [Rand].
+func NewShake(maxOffset, decayRate float32) Shake {
+	return NewShakeWith(globalRand, maxOffset, decayRate)
+}
+
+// Creates a new [Shake] which uses the given random number generator
+// for the offsets.
+//
+// Useful for reproducible shakes that don't touch the global state,
+// such as using a [rand.Rand] created from a replay seed.
+func NewShakeWith(r Float32er, maxOffset, decayRate float32) Shake {
+	return Shake{MaxOffset: maxOffset, DecayRate: decayRate, rand: r}
+}
+
+// Current trauma in the range [0, 1].
+func (s *Shake) Trauma() float32 {
+	return s.trauma
+}
+
+// Adds trauma, such as when taking a hit.
+//
+// The resulting trauma is clamped to the range [0, 1].
+func (s *Shake) AddTrauma(amount float32) {
+	s.trauma = ffmath.Clamp01(s.trauma + amount)
+}
+
+// Decays the trauma and returns a pseudo-random offset to apply to the camera.
+//
+// Should be called once per frame, with "delta" being the time since the last update.
+// The offset is zero when the trauma has fully decayed.
+func (s *Shake) Update(delta float32) ffmath.Vec {
+	s.trauma = ffmath.MoveTowards(s.trauma, 0, s.DecayRate*delta)
+	if s.trauma == 0 {
+		return ffmath.Vec{}
+	}
+	var r Float32er = globalRand
+	if s.rand != nil {
+		r = s.rand
+	}
+	amount := s.MaxOffset * s.trauma * s.trauma
+	return ffmath.V(
+		ffmath.Lerp(-amount, amount, r.Float32()),
+		ffmath.Lerp(-amount, amount, r.Float32()),
+	)
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/applejag/firefly-go-math/ffmath"
+)
+
+// Largest offset on any axis over many updates at a fixed trauma.
+func maxShakeOffset(t *testing.T, trauma float32) float32 {
+	t.Helper()
+	const maxOffset = 8
+	shake := NewShakeWith(rand.New(rand.NewSource(1)), maxOffset, 0)
+	shake.AddTrauma(trauma)
+	bound := maxOffset * trauma * trauma
+	var largest float32
+	for range 1000 {
+		offset := shake.Update(1)
+		if ffmath.Abs(offset.X) > bound || ffmath.Abs(offset.Y) > bound {
+			t.Fatalf("trauma %v: offset %v exceeds MaxOffset*trauma² = %v", trauma, offset, bound)
+		}
+		largest = max(largest, ffmath.Abs(offset.X), ffmath.Abs(offset.Y))
+	}
+	return largest
+}
+
+func TestShakeScalesWithTraumaSquared(t *testing.T) {
+	t.Parallel()
+	full := maxShakeOffset(t, 1)
+	half := maxShakeOffset(t, 0.5)
+	// same seed, so the offsets are exactly scaled by (0.5²)/(1²)
+	if !ffmath.EqualApprox(half, full/4) {
+		t.Errorf("largest offset at trauma 0.5: want a quarter of %v, got %v", full, half)
+	}
+	if full < 7 {
+		t.Errorf("largest offset at trauma 1: want close to MaxOffset 8, got %v", full)
+	}
+}
+
+func TestShakeDecaysToZero(t *testing.T) {
+	t.Parallel()
+	shake := NewShakeWith(rand.New(rand.NewSource(1)), 8, 0.5)
+	shake.AddTrauma(1)
+
+	if offset := shake.Update(1); offset == (ffmath.Vec{}) {
+		t.Errorf("Update(1) halfway through decay: want non-zero offset, got %v", offset)
+	}
+	if got := shake.Trauma(); got != 0.5 {
+		t.Errorf("Trauma() halfway through decay: want 0.5, got %v", got)
+	}
+
+	// 1/DecayRate = 2 seconds in total
+	if offset := shake.Update(1); offset != (ffmath.Vec{}) {
+		t.Errorf("Update(1) after decay: want {0,0}, got %v", offset)
+	}
+	if got := shake.Trauma(); got != 0 {
+		t.Errorf("Trauma() after decay: want 0, got %v", got)
+	}
+	if offset := shake.Update(1); offset != (ffmath.Vec{}) {
+		t.Errorf("Update(1) when already decayed: want {0,0}, got %v", offset)
+	}
+}
+
+func TestShakeStructLiteral(t *testing.T) {
+	t.Parallel()
+	shake := Shake{MaxOffset: 4, DecayRate: 1}
+	shake.AddTrauma(1)
+
+	// uses the default Rand, so check a few updates in case one lands on {0,0}
+	var shook bool
+	for range 10 {
+		offset := shake.Update(0)
+		if ffmath.Abs(offset.X) > 4 || ffmath.Abs(offset.Y) > 4 {
+			t.Fatalf("Update(0) = %v, want within MaxOffset 4", offset)
+		}
+		shook = shook || offset != (ffmath.Vec{})
+	}
+	if !shook {
+		t.Errorf("Update(0) on struct literal Shake with trauma: want non-zero offset, got {0,0}")
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"github.com/applejag/firefly-go-math/ffmath"
+	"github.com/firefly-zero/firefly-go/firefly"
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Pseudo-random walk, for coherent ambient motion such as idle creatures
+// and floating particles.
+//
+// Unlike picking a new random direction every frame, which looks jittery,
+// the walk keeps a heading that only turns a little bit on each step.
+type RandomWalk struct {
+	position ffmath.Vec
+	heading  firefly.Angle
+	maxTurn  firefly.Angle
+	speed    float32
+}
+
+// Creates a new [RandomWalk] at the "start" position, heading in a
+// pseudo-random direction.
+//
+// On each step, the heading turns by at most "maxTurn" in either direction,
+// and the position moves forward by "speed" per unit of time.
+func NewRandomWalk(start ffmath.Vec, maxTurn firefly.Angle, speed float32) RandomWalk {
+	return RandomWalk{
+		position: start,
+		heading:  Angle(),
+		maxTurn:  firefly.Radians(tinymath.Abs(maxTurn.Radians())),
+		speed:    speed,
+	}
+}
+
+// Current position of the walk.
+func (w *RandomWalk) Position() ffmath.Vec {
+	return w.position
+}
+
+// Current heading of the walk, in the same direction as [ffmath.Vec.Azimuth].
+func (w *RandomWalk) Heading() firefly.Angle {
+	return w.heading
+}
+
+// Turns the heading by a pseudo-random amount up to "maxTurn" and moves
+// forward by speed*delta, returning the new position.
+//
+// Should be called once per frame, with "delta" being the time since the last update.
+func (w *RandomWalk) Step(delta float32) ffmath.Vec {
+	turn := Float32Range(-w.maxTurn.Radians(), w.maxTurn.Radians())
+	return w.step(delta, firefly.Radians(turn))
+}
+
+func (w *RandomWalk) step(delta float32, turn firefly.Angle) ffmath.Vec {
+	limit := w.maxTurn.Radians()
+	turn = firefly.Radians(ffmath.Clamp(turn.Radians(), -limit, limit))
+	w.heading = firefly.Radians(ffmath.Repeat(w.heading.Add(turn).Radians(), tinymath.Tau))
+	w.position = w.position.Add(ffmath.V(w.speed*delta, 0).Rotate(w.heading))
+	return w.position
+}
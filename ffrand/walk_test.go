@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2026 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package ffrand
+
+import (
+	"math"
+	"testing"
+
+	"github.com/applejag/firefly-go-math/ffmath"
+	"github.com/firefly-zero/firefly-go/firefly"
+)
+
+func TestRandomWalkStep(t *testing.T) {
+	t.Parallel()
+	maxTurn := firefly.Degrees(15)
+	// constructed directly to avoid calling firefly.GetRandom
+	walk := RandomWalk{
+		position: ffmath.V(10, 20),
+		maxTurn:  maxTurn,
+		speed:    30,
+	}
+	const delta = 1.0 / 60
+
+	turns := []float32{0, 15, -15, 7.5, 90, -90, -180, 14.9}
+	for _, turnDeg := range turns {
+		prevPos := walk.Position()
+		prevHeading := walk.Heading()
+
+		pos := walk.step(delta, firefly.Degrees(turnDeg))
+
+		if pos != walk.Position() {
+			t.Errorf("step(%v°): returned %v, but Position() is %v", turnDeg, pos, walk.Position())
+		}
+		diff := pos.Sub(prevPos)
+		dist := float32(math.Sqrt(float64(diff.RadiusSquared())))
+		// Vec.Rotate has a max error of 0.002
+		if want := float32(30 * delta); ffmath.Abs(dist-want) > want*0.002 {
+			t.Errorf("step(%v°): moved %v, want %v", turnDeg, dist, want)
+		}
+		change := ffmath.AngleDifference(prevHeading, walk.Heading())
+		if ffmath.Abs(change.Degrees()) > maxTurn.Degrees()+0.001 {
+			t.Errorf("step(%v°): heading changed by %v°, which exceeds max turn %v°",
+				turnDeg, change.Degrees(), maxTurn.Degrees())
+		}
+	}
+}